@@ -0,0 +1,258 @@
+package gsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+//BulkOperator is an interface defining API of a bulk operator
+type BulkOperator interface {
+	BulkExecute(ctx context.Context, n int, fn func(ctx context.Context, i int) error) error
+	GetServers(ctx context.Context, ids []string) ([]ServerResult, error)
+	GetStorages(ctx context.Context, ids []string) ([]StorageResult, error)
+	GetNetworks(ctx context.Context, ids []string) ([]NetworkResult, error)
+	GetIPs(ctx context.Context, ids []string) ([]IPResult, error)
+	DeleteServers(ctx context.Context, ids []string) ([]OperationResult, error)
+	DeleteStorages(ctx context.Context, ids []string) ([]OperationResult, error)
+	DeleteNetworks(ctx context.Context, ids []string) ([]OperationResult, error)
+	DeleteIPs(ctx context.Context, ids []string) ([]OperationResult, error)
+}
+
+//BulkError is returned by bulk functions when some of the items have failed. The results of all other items
+//are returned nonetheless. It matches an error when checked with errors.Is if one of the failed items does.
+type BulkError struct {
+	//Errors of the failed items by their index
+	Errors map[int]error
+
+	//Number of all items
+	Total int
+}
+
+//Error just returns error as string
+func (e BulkError) Error() string {
+	indexes := e.Failed()
+	if len(indexes) == 0 {
+		return fmt.Sprintf("0 of %d items failed", e.Total)
+	}
+	return fmt.Sprintf("%d of %d items failed, item %d: %v", len(indexes), e.Total, indexes[0], e.Errors[indexes[0]])
+}
+
+//Is reports whether one of the errors of the failed items matches the target
+func (e BulkError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+//Failed returns the indexes of the failed items in ascending order
+func (e BulkError) Failed() []int {
+	var indexes []int
+	for i := range e.Errors {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+//BulkExecute calls fn for all indexes from 0 to n-1, with at most as many calls at the same time as set with
+//WithBulkConcurrency. All calls share the client, including its rate limiter and retry policy.
+//
+//A failed item does not stop the others. If some items have failed, a BulkError with their errors is returned.
+//Items which have not been started when the context is done fail with the error of the context.
+//An ArgumentError is returned if n is negative.
+func (c *Client) BulkExecute(ctx context.Context, n int, fn func(ctx context.Context, i int) error) error {
+	if n < 0 {
+		return newArgumentError("'n' must not be negative", "n")
+	}
+	if n == 0 {
+		return nil
+	}
+	workers := c.cfg.bulkConcurrency
+	if workers < 1 {
+		workers = defaultBulkConcurrency
+	}
+	if workers > n {
+		workers = n
+	}
+	errs := make([]error, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fn(ctx, i)
+			}
+		}()
+	}
+	next := 0
+	for ; next < n; next++ {
+		select {
+		case indexes <- next:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	close(indexes)
+	wg.Wait()
+	for i := next; i < n; i++ {
+		errs[i] = ctx.Err()
+	}
+	bulkErr := BulkError{Total: n}
+	for i, err := range errs {
+		if err != nil {
+			if bulkErr.Errors == nil {
+				bulkErr.Errors = make(map[int]error)
+			}
+			bulkErr.Errors[i] = err
+		}
+	}
+	if bulkErr.Errors != nil {
+		return bulkErr
+	}
+	return nil
+}
+
+//ServerResult is the result of GetServers for a single server
+type ServerResult struct {
+	//UUID of the server
+	ID string
+
+	//The server, if it has been read successfully
+	Server Server
+
+	//Error of reading the server
+	Err error
+}
+
+//GetServers reads servers concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) GetServers(ctx context.Context, ids []string) ([]ServerResult, error) {
+	results := make([]ServerResult, len(ids))
+	err := c.BulkExecute(ctx, len(ids), func(ctx context.Context, i int) error {
+		results[i].ID = ids[i]
+		results[i].Server, results[i].Err = c.GetServer(ctx, ids[i])
+		return results[i].Err
+	})
+	return results, err
+}
+
+//StorageResult is the result of GetStorages for a single storage
+type StorageResult struct {
+	//UUID of the storage
+	ID string
+
+	//The storage, if it has been read successfully
+	Storage Storage
+
+	//Error of reading the storage
+	Err error
+}
+
+//GetStorages reads storages concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) GetStorages(ctx context.Context, ids []string) ([]StorageResult, error) {
+	results := make([]StorageResult, len(ids))
+	err := c.BulkExecute(ctx, len(ids), func(ctx context.Context, i int) error {
+		results[i].ID = ids[i]
+		results[i].Storage, results[i].Err = c.GetStorage(ctx, ids[i])
+		return results[i].Err
+	})
+	return results, err
+}
+
+//NetworkResult is the result of GetNetworks for a single network
+type NetworkResult struct {
+	//UUID of the network
+	ID string
+
+	//The network, if it has been read successfully
+	Network Network
+
+	//Error of reading the network
+	Err error
+}
+
+//GetNetworks reads networks concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) GetNetworks(ctx context.Context, ids []string) ([]NetworkResult, error) {
+	results := make([]NetworkResult, len(ids))
+	err := c.BulkExecute(ctx, len(ids), func(ctx context.Context, i int) error {
+		results[i].ID = ids[i]
+		results[i].Network, results[i].Err = c.GetNetwork(ctx, ids[i])
+		return results[i].Err
+	})
+	return results, err
+}
+
+//IPResult is the result of GetIPs for a single IP address
+type IPResult struct {
+	//UUID of the IP address
+	ID string
+
+	//The IP address, if it has been read successfully
+	IP IP
+
+	//Error of reading the IP address
+	Err error
+}
+
+//GetIPs reads IP addresses concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) GetIPs(ctx context.Context, ids []string) ([]IPResult, error) {
+	results := make([]IPResult, len(ids))
+	err := c.BulkExecute(ctx, len(ids), func(ctx context.Context, i int) error {
+		results[i].ID = ids[i]
+		results[i].IP, results[i].Err = c.GetIP(ctx, ids[i])
+		return results[i].Err
+	})
+	return results, err
+}
+
+//OperationResult is the result of a bulk function changing objects (e.g. DeleteStorages) for a single object
+type OperationResult struct {
+	//UUID of the object
+	ID string
+
+	//The operation, if the request has been accepted
+	Operation *Operation
+
+	//Error of the request
+	Err error
+}
+
+//DeleteServers deletes servers concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) DeleteServers(ctx context.Context, ids []string) ([]OperationResult, error) {
+	return c.bulkOperation(ctx, ids, c.DeleteServer)
+}
+
+//DeleteStorages deletes storages concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) DeleteStorages(ctx context.Context, ids []string) ([]OperationResult, error) {
+	return c.bulkOperation(ctx, ids, c.DeleteStorage)
+}
+
+//DeleteNetworks deletes networks concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) DeleteNetworks(ctx context.Context, ids []string) ([]OperationResult, error) {
+	return c.bulkOperation(ctx, ids, c.DeleteNetwork)
+}
+
+//DeleteIPs deletes IP addresses concurrently, see BulkExecute. The results are in the order of the UUIDs.
+func (c *Client) DeleteIPs(ctx context.Context, ids []string) ([]OperationResult, error) {
+	return c.bulkOperation(ctx, ids, c.DeleteIP)
+}
+
+//bulkOperation calls a function changing an object for all UUIDs concurrently
+func (c *Client) bulkOperation(ctx context.Context, ids []string,
+	fn func(ctx context.Context, id string) (*Operation, error)) ([]OperationResult, error) {
+	results := make([]OperationResult, len(ids))
+	err := c.BulkExecute(ctx, len(ids), func(ctx context.Context, i int) error {
+		results[i].ID = ids[i]
+		results[i].Operation, results[i].Err = fn(ctx, ids[i])
+		return results[i].Err
+	})
+	return results, err
+}
@@ -0,0 +1,121 @@
+package gsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const missingUUID = "f0e0fe9b-5d6c-4ef6-a4b4-1a7e0aa5c0b1"
+
+func TestClient_GetServers(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	mux.HandleFunc(apiServerBase+"/", func(w http.ResponseWriter, r *http.Request) {
+		id := path.Base(r.URL.Path)
+		if id == missingUUID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"server": {"object_uuid": "%s"}}`, id)
+	})
+	ids := []string{dummyUUID, missingUUID, "invalid"}
+	results, err := client.GetServers(emptyCtx, ids)
+	if assert.Len(t, results, 3) {
+		for i, result := range results {
+			assert.Equal(t, ids[i], result.ID)
+		}
+		assert.Nil(t, results[0].Err)
+		assert.Equal(t, dummyUUID, results[0].Server.Properties.ObjectUUID)
+		assert.True(t, errors.Is(results[1].Err, ErrNotFound))
+		assert.True(t, errors.Is(results[2].Err, ErrInvalidArgument))
+	}
+	var bulkErr BulkError
+	if assert.True(t, errors.As(err, &bulkErr)) {
+		assert.Equal(t, 3, bulkErr.Total)
+		assert.Equal(t, []int{1, 2}, bulkErr.Failed())
+	}
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+
+	results, err = client.GetServers(emptyCtx, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, results)
+}
+
+func TestClient_DeleteStorages(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	var mu sync.Mutex
+	var deleted []string
+	mux.HandleFunc(apiStorageBase+"/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		mu.Lock()
+		deleted = append(deleted, path.Base(r.URL.Path))
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	results, err := client.DeleteStorages(emptyCtx, []string{dummyUUID, missingUUID})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{dummyUUID, missingUUID}, deleted)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, missingUUID, results[1].ID)
+		assert.Nil(t, results[1].Err)
+	}
+}
+
+func TestClient_BulkExecute(t *testing.T) {
+	client := NewClient(NewConfig(WithBulkConcurrency(3)))
+	var running, maxRunning int32
+	err := client.BulkExecute(emptyCtx, 20, func(ctx context.Context, i int) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		if i%5 == 0 {
+			return fmt.Errorf("item %d", i)
+		}
+		return nil
+	})
+	assert.True(t, maxRunning <= 3)
+	var bulkErr BulkError
+	if assert.True(t, errors.As(err, &bulkErr)) {
+		assert.Equal(t, []int{0, 5, 10, 15}, bulkErr.Failed())
+		assert.Equal(t, "4 of 20 items failed, item 0: item 0", err.Error())
+	}
+
+	//items which are not started when the context is done fail with its error
+	ctx, cancel := context.WithCancel(emptyCtx)
+	var started int32
+	err = client.BulkExecute(ctx, 20, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&started, 1)
+		cancel()
+		return nil
+	})
+	assert.True(t, errors.Is(err, context.Canceled))
+	if assert.True(t, errors.As(err, &bulkErr)) {
+		assert.Equal(t, 20-int(started), len(bulkErr.Errors))
+	}
+	//no items => fn is never called, a negative number of items is invalid
+	called := false
+	fn := func(ctx context.Context, i int) error {
+		called = true
+		return nil
+	}
+	assert.Nil(t, client.BulkExecute(emptyCtx, 0, fn))
+	assert.True(t, errors.Is(client.BulkExecute(emptyCtx, -1, fn), ErrInvalidArgument))
+	assert.False(t, called)
+}
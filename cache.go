@@ -0,0 +1,256 @@
+package gsclient
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+//CacheOptions configures the response cache of a client, see WithCache
+type CacheOptions struct {
+	//How long responses are cached, by object type. Object types are named like in Operation.ObjectType,
+	//e.g. "location", "template", "paas_service_template" or "server".
+	TTLs map[string]time.Duration
+
+	//How long responses of object types which are not in TTLs are cached. 0 => they are not cached.
+	DefaultTTL time.Duration
+}
+
+//collectionTypes are the object types of the collections in the URIs of the API
+var collectionTypes = map[string]string{
+	"servers":                    "server",
+	"storages":                   "storage",
+	"networks":                   "network",
+	"ips":                        "ip",
+	"sshkeys":                    "sshkey",
+	"templates":                  "template",
+	"loadbalancers":              "loadbalancer",
+	"isoimages":                  "isoimage",
+	"firewalls":                  "firewall",
+	"locations":                  "location",
+	"events":                     "event",
+	"labels":                     "label",
+	"snapshots":                  "snapshot",
+	"snapshot_schedules":         "snapshot_schedule",
+	"paas/services":              "paas_service",
+	"paas_services":              "paas_service",
+	"paas/service_templates":     "paas_service_template",
+	"paas/security_zones":        "paas_security_zone",
+	"objectstorages/access_keys": "object_storage_access_key",
+	"objectstorages/buckets":     "object_storage_bucket",
+}
+
+//relatedTypes are the object types whose responses embed objects of another object type in their relations,
+//e.g. servers list their storages and storages list their servers. Changing a label may change any object.
+var relatedTypes = map[string][]string{
+	"server":             {"storage", "network", "ip", "isoimage"},
+	"storage":            {"server", "ip", "snapshot", "snapshot_schedule"},
+	"network":            {"server", "firewall", "paas_service"},
+	"ip":                 {"server", "storage", "loadbalancer"},
+	"isoimage":           {"server"},
+	"loadbalancer":       {"ip"},
+	"firewall":           {"network", "server"},
+	"snapshot":           {"storage", "snapshot_schedule"},
+	"snapshot_schedule":  {"storage", "snapshot"},
+	"paas_service":       {"network", "paas_security_zone"},
+	"paas_security_zone": {"paas_service"},
+	"sshkey":             {},
+	"template":           {},
+	"location":           {},
+	"event":              {},
+}
+
+//dependentTypes returns the object types whose responses may change when objects of the given types are changed:
+//the types themselves, the types related to them and events. nil => any response may change.
+func dependentTypes(objectTypes []string) []string {
+	dependents := []string{"event"}
+	for _, objectType := range objectTypes {
+		related, ok := relatedTypes[objectType]
+		if !ok {
+			return nil
+		}
+		dependents = append(append(dependents, objectType), related...)
+	}
+	return dependents
+}
+
+//objectTypesOf returns the object types of the collections in a URI, e.g. "server" and "storage" for
+///objects/servers/{id}/storages/{id}. Collections without object type (e.g. metrics) are returned as empty string.
+func objectTypesOf(uri string) []string {
+	if i := strings.IndexByte(uri, '?'); i >= 0 {
+		uri = uri[:i]
+	}
+	segments := strings.Split(strings.Trim(uri, "/"), "/")
+	if len(segments) < 2 || segments[0] != "objects" {
+		return nil
+	}
+	var types []string
+	for i := 1; i < len(segments); i += 2 {
+		collection := segments[i]
+		switch collection {
+		case "deleted":
+			//deleted objects are listed in /objects/deleted/{collection}
+			i--
+			continue
+		case "paas", "objectstorages":
+			if i+1 < len(segments) {
+				i++
+				collection += "/" + segments[i]
+			}
+		}
+		types = append(types, collectionTypes[collection])
+	}
+	return types
+}
+
+//cacheContextKey is the key of the context value set by WithoutCache
+type cacheContextKey struct{}
+
+//WithoutCache returns a context whose requests bypass the response cache, see WithCache. Their responses
+//are cached nonetheless, so that subsequent requests get the fresh state.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheContextKey{}, true)
+}
+
+//cacheEntry is a cached response
+type cacheEntry struct {
+	objectType string
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+//responseCache caches successful responses of GET requests, see WithCache
+type responseCache struct {
+	options CacheOptions
+	now     func() time.Time
+
+	//mu guards entries and generations
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+
+	//generations count how often object types have been invalidated, so that responses of requests which
+	//were sent before an invalidation are not cached afterwards
+	generations map[string]uint64
+}
+
+//newResponseCache creates an empty cache
+func newResponseCache(options CacheOptions) *responseCache {
+	return &responseCache{
+		options:     options,
+		now:         time.Now,
+		entries:     make(map[string]*cacheEntry),
+		generations: make(map[string]uint64),
+	}
+}
+
+//ttl returns how long responses of an object type are cached
+func (rc *responseCache) ttl(objectType string) time.Duration {
+	if ttl, ok := rc.options.TTLs[objectType]; ok {
+		return ttl
+	}
+	return rc.options.DefaultTTL
+}
+
+//invalidate removes the cached responses of the given object types, or all of them if no type is given
+func (rc *responseCache) invalidate(objectTypes ...string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if len(objectTypes) == 0 {
+		for objectType := range rc.generations {
+			rc.generations[objectType]++
+		}
+		for _, entry := range rc.entries {
+			rc.generations[entry.objectType]++
+		}
+		rc.entries = make(map[string]*cacheEntry)
+		return
+	}
+	for _, objectType := range objectTypes {
+		rc.generations[objectType]++
+	}
+	for uri, entry := range rc.entries {
+		if containsString(objectTypes, entry.objectType) {
+			delete(rc.entries, uri)
+		}
+	}
+}
+
+//middleware returns the middleware answering GET requests from the cache. It is the innermost middleware,
+//so that all other middlewares see the cached responses as well.
+func (rc *responseCache) middleware(next RequestHandler) RequestHandler {
+	return func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+		objectTypes := objectTypesOf(req.URI)
+		if len(objectTypes) == 0 {
+			return next(ctx, req)
+		}
+		if req.Method != http.MethodGet {
+			res := next(ctx, req)
+			rc.invalidate(dependentTypes(objectTypes)...)
+			return res
+		}
+		objectType := objectTypes[len(objectTypes)-1]
+		ttl := rc.ttl(objectType)
+		if objectType == "" || ttl <= 0 {
+			return next(ctx, req)
+		}
+		bypass, _ := ctx.Value(cacheContextKey{}).(bool)
+
+		rc.mu.Lock()
+		entry := rc.entries[req.URI]
+		generation := rc.generations[objectType]
+		rc.mu.Unlock()
+		if entry != nil && !bypass && rc.now().Before(entry.expires) {
+			return entry.response()
+		}
+		if entry != nil && entry.header.Get("ETag") != "" {
+			req.Header.Set("If-None-Match", entry.header.Get("ETag"))
+		}
+
+		res := next(ctx, req)
+		switch {
+		case res.StatusCode == http.StatusNotModified && entry != nil:
+			//the cached response is still valid, cached entries are never modified
+			latency := res.Latency
+			res = entry.response()
+			res.Latency = latency
+			revalidated := *entry
+			entry = &revalidated
+		case res.StatusCode != http.StatusOK || res.Err != nil || len(res.Body) == 0:
+			//errors are not cached, and neither are responses streamed to iterators
+			return res
+		default:
+			entry = &cacheEntry{
+				objectType: objectType,
+				header:     cloneHeader(res.Header),
+				body:       res.Body,
+			}
+		}
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		if rc.generations[objectType] == generation {
+			entry.expires = rc.now().Add(ttl)
+			rc.entries[req.URI] = entry
+		}
+		return res
+	}
+}
+
+//response returns the cached response
+func (entry *cacheEntry) response() *MiddlewareResponse {
+	return &MiddlewareResponse{
+		StatusCode: http.StatusOK,
+		Header:     cloneHeader(entry.header),
+		Body:       entry.body,
+	}
+}
+
+//InvalidateCache removes the cached responses of the given object types (e.g. "server"), or all cached
+//responses if no type is given. It does nothing if the client has no cache.
+func (c *Client) InvalidateCache(objectTypes ...string) {
+	if c.cfg.cache != nil {
+		c.cfg.cache.invalidate(objectTypes...)
+	}
+}
@@ -0,0 +1,182 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectTypesOf(t *testing.T) {
+	testCases := []struct {
+		uri   string
+		types []string
+	}{
+		{"/objects/locations", []string{"location"}},
+		{"/objects/servers/" + dummyUUID, []string{"server"}},
+		{"/objects/servers/" + dummyUUID + "/storages/" + dummyUUID, []string{"server", "storage"}},
+		{"/objects/servers/" + dummyUUID + "/metrics", []string{"server", ""}},
+		{"/objects/locations/" + dummyUUID + "/templates?fields=name", []string{"location", "template"}},
+		{"/objects/deleted/storages", []string{"storage"}},
+		{"/objects/deleted/paas_services", []string{"paas_service"}},
+		{"/objects/paas/service_templates", []string{"paas_service_template"}},
+		{"/objects/paas/services/" + dummyUUID, []string{"paas_service"}},
+		{"/objects/storages/" + dummyUUID + "/snapshots/" + dummyUUID, []string{"storage", "snapshot"}},
+		{"/objects/objectstorages/access_keys", []string{"object_storage_access_key"}},
+		{"/requests/" + dummyUUID, nil},
+	}
+	for _, test := range testCases {
+		assert.Equal(t, test.types, objectTypesOf(test.uri), test.uri)
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	requests := make(map[string]int)
+	mux := http.NewServeMux()
+	mux.HandleFunc(apiLocationBase, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" locations"]++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"locations": {"a": {"object_uuid": "a", "name": "de/fra"}}}`)
+	})
+	mux.HandleFunc(apiStorageBase, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" storages"]++
+		fmt.Fprint(w, `{"storages": {"a": {"object_uuid": "a"}}}`)
+	})
+	mux.HandleFunc(apiStorageBase+"/", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" storage"]++
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(apiServerBase, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" servers"]++
+		fmt.Fprint(w, `{"servers": {}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := NewClient(NewConfig(WithAPIURL(server.URL), WithCache(CacheOptions{
+		TTLs:       map[string]time.Duration{"location": time.Hour, "server": 0},
+		DefaultTTL: time.Minute,
+	})))
+	now := time.Now()
+	client.cfg.cache.now = func() time.Time { return now }
+
+	//cached until the TTL has expired, then revalidated with the ETag
+	for i := 0; i < 3; i++ {
+		locations, err := client.GetLocationList(emptyCtx)
+		assert.Nil(t, err)
+		assert.Len(t, locations, 1)
+	}
+	assert.Equal(t, 1, requests["GET locations"])
+	now = now.Add(2 * time.Hour)
+	locations, err := client.GetLocationList(emptyCtx)
+	assert.Nil(t, err)
+	if assert.Len(t, locations, 1) {
+		assert.Equal(t, "de/fra", locations[0].Properties.Name)
+	}
+	assert.Equal(t, 2, requests["GET locations"])
+	_, err = client.GetLocationList(emptyCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests["GET locations"])
+
+	//iterators are answered from the cache as well
+	_, err = client.GetStorageList(emptyCtx)
+	assert.Nil(t, err)
+	var count int
+	assert.Nil(t, client.StoragesIter(emptyCtx, func(storage Storage) bool {
+		count++
+		return true
+	}))
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 1, requests["GET storages"])
+
+	//WithoutCache bypasses the cache
+	_, err = client.GetStorageList(WithoutCache(emptyCtx))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests["GET storages"])
+
+	//deleting a storage invalidates all storages, but not the locations
+	_, err = client.DeleteStorage(emptyCtx, dummyUUID)
+	assert.Nil(t, err)
+	_, err = client.GetStorageList(emptyCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, requests["GET storages"])
+	_, err = client.GetLocationList(emptyCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests["GET locations"])
+
+	//errors are not cached, and neither are object types with a TTL of 0
+	for i := 0; i < 2; i++ {
+		_, err = client.GetStorage(emptyCtx, dummyUUID)
+		assert.NotNil(t, err)
+		_, err = client.GetServerList(emptyCtx)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, requests["GET storage"])
+	assert.Equal(t, 2, requests["GET servers"])
+
+	client.InvalidateCache()
+	_, err = client.GetLocationList(emptyCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, requests["GET locations"])
+}
+
+func TestClient_CacheRelations(t *testing.T) {
+	storages := `[{"object_uuid": "` + dummyUUID + `"}]`
+	mux := http.NewServeMux()
+	mux.HandleFunc(apiServerBase+"/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"server": {"object_uuid": "`+dummyUUID+`", "relations": {"storages": `+storages+`}}}`)
+	})
+	mux.HandleFunc(apiStorageBase+"/", func(w http.ResponseWriter, r *http.Request) {
+		storages = `[]`
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := NewClient(NewConfig(WithAPIURL(server.URL), WithCache(CacheOptions{DefaultTTL: time.Hour})))
+
+	//deleting a storage invalidates the servers, as they list their storages in their relations
+	srv, err := client.GetServer(emptyCtx, dummyUUID)
+	assert.Nil(t, err)
+	assert.Len(t, srv.Properties.Relations.Storages, 1)
+	_, err = client.DeleteStorage(emptyCtx, dummyUUID)
+	assert.Nil(t, err)
+	srv, err = client.GetServer(emptyCtx, dummyUUID)
+	assert.Nil(t, err)
+	assert.Empty(t, srv.Properties.Relations.Storages)
+}
+
+func TestDependentTypes(t *testing.T) {
+	assert.ElementsMatch(t, []string{"event", "storage", "server", "ip", "snapshot", "snapshot_schedule"},
+		dependentTypes([]string{"storage"}))
+	assert.Nil(t, dependentTypes([]string{"label"}))
+	assert.Nil(t, dependentTypes([]string{"server", ""}))
+}
+
+func TestResponseCache_Invalidation(t *testing.T) {
+	//a response of a request which was sent before the object type has been invalidated is not cached
+	cache := newResponseCache(CacheOptions{DefaultTTL: time.Hour})
+	var requests int
+	handler := cache.middleware(func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+		requests++
+		if requests == 1 {
+			cache.invalidate("network")
+		}
+		return &MiddlewareResponse{StatusCode: http.StatusOK, Body: []byte(`{}`)}
+	})
+	for i := 0; i < 3; i++ {
+		res := handler(emptyCtx, &MiddlewareRequest{Method: http.MethodGet, URI: apiNetworkBase, Header: http.Header{}})
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.Equal(t, 2, requests)
+}
@@ -1,11 +1,18 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"fmt"
 	"path"
 	"strings"
 )
 
+//RequestOperator is an interface defining API of a request operator
+type RequestOperator interface {
+	GetRequestStatus(ctx context.Context, id string) (RequestStatusProperties, error)
+	WaitForRequest(ctx context.Context, id string) error
+}
+
 const (
 	requestBase          = "/requests/"
 	apiServerBase        = "/objects/servers"
@@ -38,67 +45,88 @@ func NewClient(c *Config) *Client {
 	return client
 }
 
+//GetRequestStatus gets the current status of an asynchronous request
+//
+//See: https://gridscale.io/en//api-documentation/index.html#operation/getRequest
+func (c *Client) GetRequestStatus(ctx context.Context, id string) (RequestStatusProperties, error) {
+	if strings.TrimSpace(id) == "" {
+		return RequestStatusProperties{}, newArgumentError("'id' is required", "id")
+	}
+	r := Request{
+		uri:    path.Join(requestBase, id),
+		method: "GET",
+	}
+	var response RequestStatus
+	err := r.execute(ctx, c, &response)
+	if err != nil {
+		return RequestStatusProperties{}, err
+	}
+	status, ok := response[id]
+	if !ok {
+		return RequestStatusProperties{}, fmt.Errorf("Request %v %w", id, ErrNotFound)
+	}
+	return status, nil
+}
+
+//WaitForRequest waits until an asynchronous request (e.g. CreateResponse.RequestUUID) is done.
+//It returns a RequestStatusError as soon as the request has failed or has been cancelled.
+func (c *Client) WaitForRequest(ctx context.Context, id string) error {
+	return c.waitForRequestCompleted(ctx, id)
+}
+
 //waitForRequestCompleted allows to wait for a request to complete
-func (c *Client) waitForRequestCompleted(id string) error {
+func (c *Client) waitForRequestCompleted(ctx context.Context, id string) error {
 	if strings.TrimSpace(id) == "" {
-		return errors.New("'id' is required")
+		return newArgumentError("'id' is required", "id")
 	}
-	return retryWithTimeout(func() (bool, error) {
-		r := Request{
-			uri:    path.Join(requestBase, id),
-			method: "GET",
-		}
-		var response RequestStatus
-		err := r.execute(*c, &response)
-		if err != nil {
-			return false, err
-		}
-		if response[id].Status == requestDoneStatus {
-			c.cfg.logger.Info("Done with creating")
-			return false, nil
-		}
-		return true, nil
+	return retryWithTimeout(ctx, func() (bool, error) {
+		done, err := c.checkRequest(ctx, id)
+		return !done && err == nil, err
 	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
 }
 
+//checkRequest gets the status of a request once and reports whether it is done.
+//It returns a RequestStatusError if the request has failed or has been cancelled.
+func (c *Client) checkRequest(ctx context.Context, id string) (bool, error) {
+	status, err := c.GetRequestStatus(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	switch status.Status {
+	case requestDoneStatus:
+		c.cfg.logger.Info("Request is done", Fields{"request_uuid": id})
+		return true, nil
+	case requestFailedStatus, requestCancelledStatus:
+		return true, RequestStatusError{
+			RequestUUID: id,
+			Status:      status.Status,
+			Message:     status.Message,
+			CreateTime:  status.CreateTime,
+		}
+	}
+	return false, nil
+}
+
 //waitFor404Status waits until server returns 404 status code
-func (c *Client) waitFor404Status(uri, method string) error {
-	return retryWithTimeout(func() (bool, error) {
+func (c *Client) waitFor404Status(ctx context.Context, uri, method string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
 		r := Request{
 			uri:          uri,
 			method:       method,
 			skipPrint404: true,
 		}
-		err := r.execute(*c, nil)
-		if err != nil {
-			if requestError, ok := err.(RequestError); ok {
-				if requestError.StatusCode == 404 {
-					return false, nil
-				}
-			}
-			return false, err
-		}
-		return true, nil
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+		return nil, r.execute(ctx, c, nil)
+	}, ConditionDeleted, c.waitOptions())
 }
 
 //waitFor200Status waits until server returns 200 (OK) status code
-func (c *Client) waitFor200Status(uri, method string) error {
-	return retryWithTimeout(func() (bool, error) {
+func (c *Client) waitFor200Status(ctx context.Context, uri, method string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
 		r := Request{
 			uri:          uri,
 			method:       method,
 			skipPrint404: true,
 		}
-		err := r.execute(*c, nil)
-		if err != nil {
-			if requestError, ok := err.(RequestError); ok {
-				if requestError.StatusCode == 404 {
-					return true, nil
-				}
-			}
-			return false, err
-		}
-		return false, nil
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+		return nil, r.execute(ctx, c, nil)
+	}, ConditionRelationExists, c.waitOptions())
 }
@@ -1,10 +1,13 @@
 package gsclient
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestClient_waitForRequestCompleted(t *testing.T) {
@@ -25,7 +28,7 @@ func TestClient_waitForRequestCompleted(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, testUUID := range uuidCommonTestCases {
-				err := client.waitForRequestCompleted(testUUID.testUUID)
+				err := client.waitForRequestCompleted(emptyCtx, testUUID.testUUID)
 				if isFailed || reqStatus != requestDoneStatus || testUUID.isFailed {
 					assert.NotNil(t, err)
 				} else {
@@ -51,7 +54,7 @@ func TestClient_waitFor404Status(t *testing.T) {
 	})
 	for _, notFound := range notfoundTestCases {
 		isNotFound = notFound
-		err := client.waitFor404Status(uri, http.MethodGet)
+		err := client.waitFor404Status(emptyCtx, uri, http.MethodGet)
 		if isNotFound {
 			assert.Nil(t, err)
 		} else {
@@ -75,7 +78,7 @@ func TestClient_waitFor200Status(t *testing.T) {
 	})
 	for _, found := range foundTestCases {
 		isFound = found
-		err := client.waitFor200Status(uri, http.MethodGet)
+		err := client.waitFor200Status(emptyCtx, uri, http.MethodGet)
 		if found {
 			assert.Nil(t, err)
 		} else {
@@ -83,3 +86,62 @@ func TestClient_waitFor200Status(t *testing.T) {
 		}
 	}
 }
+
+func TestClient_waitForRequestCompletedWithDeadline(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	mux.HandleFunc(requestBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fmt.Sprintf(`{"%s": {"status":"pending"}}`, dummyUUID))
+	})
+	ctx, cancel := context.WithTimeout(emptyCtx, 250*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := client.waitForRequestCompleted(ctx, dummyUUID)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < client.cfg.requestCheckTimeoutSecs)
+}
+
+func TestClient_waitForRequestCompletedFailed(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	var calls int
+	var reqStatus string
+	mux.HandleFunc(requestBase, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, fmt.Sprintf(`{"%s": {"status":"%s", "message":"no capacity left", "create_time":"2018-04-28T09:47:41Z"}}`, dummyUUID, reqStatus))
+	})
+	for _, status := range []string{requestFailedStatus, requestCancelledStatus} {
+		calls = 0
+		reqStatus = status
+		err := client.WaitForRequest(emptyCtx, dummyUUID)
+		assert.True(t, errors.Is(err, ErrRequestFailed))
+		var statusError RequestStatusError
+		if assert.True(t, errors.As(err, &statusError)) {
+			assert.Equal(t, dummyUUID, statusError.RequestUUID)
+			assert.Equal(t, status, statusError.Status)
+			assert.Equal(t, "no capacity left", statusError.Message)
+			assert.Equal(t, dummyTime, statusError.CreateTime)
+		}
+		assert.Equal(t, 1, calls)
+	}
+}
+
+func TestClient_GetRequestStatus(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	mux.HandleFunc(requestBase, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, fmt.Sprintf(`{"%s": {"status":"pending", "message":"", "create_time":"2018-04-28T09:47:41Z"}}`, dummyUUID))
+	})
+	for _, test := range uuidCommonTestCases {
+		status, err := client.GetRequestStatus(emptyCtx, test.testUUID)
+		if test.isFailed {
+			assert.True(t, errors.Is(err, ErrInvalidArgument))
+		} else {
+			assert.Nil(t, err, "GetRequestStatus returned an error %v", err)
+			assert.Equal(t, RequestStatusProperties{Status: "pending", CreateTime: dummyTime}, status)
+		}
+	}
+	_, err := client.GetRequestStatus(emptyCtx, dummyRequestUUID)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
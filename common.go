@@ -1,33 +1,38 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"github.com/google/uuid"
+	"net/http"
 	"time"
 )
 
 type isContinue func() (bool, error)
 
+type attemptFunc func(attempt int) (*http.Response, error)
+
 //isValidUUID validates the uuid
 func isValidUUID(u string) bool {
 	_, err := uuid.Parse(u)
 	return err == nil
 }
 
-//retryWithTimeout reruns a function within a period of time
-func retryWithTimeout(targetFunc isContinue, timeout, delay time.Duration) error {
+//retryWithTimeout reruns a function within a period of time.
+//It stops as soon as the context is cancelled or its deadline is exceeded.
+func retryWithTimeout(ctx context.Context, targetFunc isContinue, timeout, delay time.Duration) error {
 	timer := time.After(timeout)
 	var err error
 	var continueRetrying bool
 	for {
 		select {
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-timer:
 			if err != nil {
 				return err
 			}
-			return errors.New("timeout reached")
-		default:
-			time.Sleep(delay) //delay between retries
+			return ErrTimeout
+		case <-time.After(delay): //delay between retries
 			continueRetrying, err = targetFunc()
 			if !continueRetrying {
 				return err
@@ -36,21 +41,58 @@ func retryWithTimeout(targetFunc isContinue, timeout, delay time.Duration) error
 	}
 }
 
-//retryWithLimitedNumOfRetries reruns a function within a number of retries
-func retryWithLimitedNumOfRetries(targetFunc isContinue, numOfRetries int, delay time.Duration) error {
-	retryNo := 0
-	var err error
-	var continueRetrying bool
-	for retryNo <= numOfRetries {
-		time.Sleep(delay) //delay between retries
-		continueRetrying, err = targetFunc()
-		if !continueRetrying {
+//retryWithPolicy runs a function and reruns it as long as the retry policy allows it.
+//Unlike retryWithTimeout, the first attempt is made without any delay.
+//It stops as soon as the context is cancelled or its deadline is exceeded.
+func retryWithPolicy(ctx context.Context, targetFunc attemptFunc, policy RetryPolicy, logger Logger, fields Fields) error {
+	for attempt := 1; ; attempt++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		resp, err := targetFunc(attempt)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !policy.ShouldRetry(attempt, resp, err) {
 			return err
 		}
-		retryNo++
+		delay := policy.Delay(attempt, resp)
+		logger.Debug("Attempt failed, retrying", fields.with(Fields{
+			"attempt": attempt,
+			"error":   err,
+			"delay":   delay,
+		}))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
 	}
-	if err != nil {
-		return err
+}
+
+//String returns a pointer to a string, e.g. to set an optional field of an update request
+func String(v string) *string {
+	return &v
+}
+
+//Int returns a pointer to an int, e.g. to set an optional field of an update request
+func Int(v int) *int {
+	return &v
+}
+
+//Bool returns a pointer to a bool, e.g. to set an optional field of an update request
+func Bool(v bool) *bool {
+	return &v
+}
+
+//Strings returns a pointer to a list of strings, e.g. to set the labels in an update request.
+//Strings() without arguments returns an empty list, which removes all labels.
+func Strings(v ...string) *[]string {
+	if v == nil {
+		v = []string{}
 	}
-	return errors.New("timeout reached")
+	return &v
 }
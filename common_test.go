@@ -1,9 +1,12 @@
 package gsclient
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"testing"
 	"time"
 )
@@ -64,7 +67,7 @@ func Test_retryWithTimeout(t *testing.T) {
 		},
 	}
 	for _, test := range testCases {
-		err := retryWithTimeout(func() (bool, error) {
+		err := retryWithTimeout(emptyCtx, func() (bool, error) {
 			return test.isContinue, test.err
 		}, test.timeout, test.delay)
 		if test.err != nil || test.isContinue {
@@ -75,41 +78,95 @@ func Test_retryWithTimeout(t *testing.T) {
 	}
 }
 
-func Test_retryWithLimitedNumOfRetries(t *testing.T) {
+func Test_retryWithPolicy(t *testing.T) {
 	type testCase struct {
-		isContinue   bool
-		err          error
-		delay        time.Duration
-		numOfRetries int
+		failures int
+		attempts int
+		isFailed bool
 	}
 	testCases := []testCase{
-		{
-			true,
-			nil,
-			time.Duration(500) * time.Millisecond,
-			10,
-		},
-		{
-			false,
-			nil,
-			time.Duration(500) * time.Millisecond,
-			10,
-		},
-		{
-			false,
-			errors.New("just test"),
-			time.Duration(500) * time.Millisecond,
-			10,
-		},
+		{failures: 0, attempts: 1, isFailed: false},
+		{failures: 2, attempts: 3, isFailed: false},
+		{failures: 10, attempts: 4, isFailed: true},
 	}
+	policy := &ExponentialBackoffRetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}
 	for _, test := range testCases {
-		err := retryWithLimitedNumOfRetries(func() (bool, error) {
-			return test.isContinue, test.err
-		}, test.numOfRetries, test.delay)
-		if test.err != nil || test.isContinue {
+		attempts := 0
+		err := retryWithPolicy(emptyCtx, func(int) (*http.Response, error) {
+			attempts++
+			if attempts <= test.failures {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable}, errors.New("just test")
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}, policy, NoopLogger{}, nil)
+		assert.Equal(t, test.attempts, attempts)
+		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
 			assert.Nil(t, err)
 		}
 	}
 }
+
+func Test_retryWithContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(emptyCtx)
+	cancel()
+	err := retryWithTimeout(ctx, func() (bool, error) {
+		return true, nil
+	}, time.Duration(1)*time.Second, time.Duration(100)*time.Millisecond)
+	assert.Equal(t, context.Canceled, err)
+
+	err = retryWithPolicy(ctx, func(int) (*http.Response, error) {
+		return nil, errors.New("just test")
+	}, &ExponentialBackoffRetryPolicy{
+		MaxRetries:     10,
+		BaseDelay:      time.Duration(100) * time.Millisecond,
+		RetryableError: func(error) bool { return true },
+	}, NoopLogger{}, nil)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestUpdateRequests_JSON(t *testing.T) {
+	type testCase struct {
+		body     interface{}
+		expected string
+	}
+	testCases := []testCase{
+		//fields which are not set are not sent
+		{body: IPUpdateRequest{}, expected: `{}`},
+		{body: IPUpdateRequest{Name: String("renamed")}, expected: `{"name":"renamed"}`},
+		//zero values can be set explicitly
+		{body: IPUpdateRequest{Failover: Bool(false)}, expected: `{"failover":false}`},
+		{body: IPUpdateRequest{ReverseDNS: String("")}, expected: `{"reverse_dns":""}`},
+		{body: NetworkUpdateRequest{Name: String("renamed")}, expected: `{"name":"renamed"}`},
+		{body: NetworkUpdateRequest{L2Security: Bool(false)}, expected: `{"l2security":false}`},
+		//labels can be removed
+		{body: StorageUpdateRequest{Labels: Strings()}, expected: `{"labels":[]}`},
+		{body: StorageUpdateRequest{Labels: Strings("a", "b")}, expected: `{"labels":["a","b"]}`},
+		{body: StorageUpdateRequest{Capacity: Int(20)}, expected: `{"capacity":20}`},
+		{body: ServerUpdateRequest{}, expected: `{}`},
+		{body: ServerUpdateRequest{Cores: Int(2), AutoRecovery: Bool(false)}, expected: `{"cores":2,"auto_recovery":false}`},
+		{body: ServerStorageRelationUpdateRequest{Ordering: Int(0)}, expected: `{"ordering":0}`},
+		{body: ServerNetworkRelationUpdateRequest{BootDevice: Bool(false)}, expected: `{"bootdevice":false}`},
+		{body: ServerNetworkRelationUpdateRequest{L3security: Strings()}, expected: `{"l3security":[]}`},
+		{body: ServerIsoImageRelationUpdateRequest{BootDevice: Bool(false)}, expected: `{"bootdevice":false}`},
+		{body: FirewallUpdateRequest{}, expected: `{}`},
+		{body: ISOImageUpdateRequest{Labels: Strings()}, expected: `{"labels":[]}`},
+		{body: LoadBalancerUpdateRequest{}, expected: `{}`},
+		{
+			body:     LoadBalancerUpdateRequest{Algorithm: &LoadbalancerLeastConnAlg, RedirectHTTPToHTTPS: Bool(false)},
+			expected: `{"algorithm":"leastconn","redirect_http_to_https":false}`,
+		},
+		{body: PaaSServiceUpdateRequest{Parameters: &map[string]interface{}{}}, expected: `{"parameters":{}}`},
+		{body: PaaSSecurityZoneUpdateRequest{Name: String("zone")}, expected: `{"name":"zone"}`},
+		{body: StorageSnapshotUpdateRequest{Name: String("snapshot")}, expected: `{"name":"snapshot"}`},
+		{body: StorageSnapshotScheduleUpdateRequest{KeepSnapshots: Int(1)}, expected: `{"keep_snapshots":1}`},
+		{body: SshkeyUpdateRequest{Labels: Strings()}, expected: `{"labels":[]}`},
+		{body: TemplateUpdateRequest{}, expected: `{}`},
+	}
+	for _, test := range testCases {
+		data, err := json.Marshal(test.body)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, string(data), "%T", test.body)
+	}
+}
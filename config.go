@@ -1,20 +1,40 @@
 package gsclient
 
 import (
+	"fmt"
 	"github.com/sirupsen/logrus"
 	"net/http"
 	"os"
 	"runtime"
+	"strconv"
 	"time"
 )
 
 const (
 	defaultCheckRequestTimeoutSecs = 120
-	defaultMaxNumberOfRetries      = 100
+	defaultMaxNumberOfRetries      = 10
 	defaultDelayIntervalMilliSecs  = 500
+	defaultMaxRetryDelaySecs       = 10
+	defaultBulkConcurrency         = 8
+	defaultAPIURL                  = "https://api.gridscale.io"
 	version                        = "1.0.0"
 	resourceActiveStatus           = "active"
 	requestDoneStatus              = "done"
+	requestFailedStatus            = "failed"
+	requestCancelledStatus         = "cancelled"
+)
+
+//Environment variables read by ConfigFromEnv
+const (
+	envUUID                    = "GRIDSCALE_UUID"
+	envToken                   = "GRIDSCALE_TOKEN"
+	envURL                     = "GRIDSCALE_URL"
+	envSync                    = "GRIDSCALE_SYNC"
+	envDebug                   = "GRIDSCALE_DEBUG"
+	envRequestCheckTimeoutSecs = "GRIDSCALE_REQUEST_CHECK_TIMEOUT_SECS"
+	envDelayIntervalMilliSecs  = "GRIDSCALE_DELAY_INTERVAL_MILLISECS"
+	envMaxNumberOfRetries      = "GRIDSCALE_MAX_NUMBER_OF_RETRIES"
+	envLocationUUID            = "GRIDSCALE_LOCATION_UUID"
 )
 
 //Config config for client
@@ -23,12 +43,18 @@ type Config struct {
 	userUUID                string
 	apiToken                string
 	userAgent               string
+	locationUUID            string
 	sync                    bool
 	httpClient              *http.Client
 	requestCheckTimeoutSecs time.Duration
 	delayInterval           time.Duration
 	maxNumberOfRetries      int
-	logger                  logrus.Logger
+	retryPolicy             RetryPolicy
+	rateLimiter             RateLimiter
+	middlewares             []Middleware
+	logger                  Logger
+	bulkConcurrency         int
+	cache                   *responseCache
 }
 
 //NewConfiguration creates a new config
@@ -37,48 +63,334 @@ type Config struct {
 //		+ apiURL string: base URL of API.
 //		+ uuid string: UUID of user.
 //		+ token string: API token.
-//		+ debugMode bool: true => run client in debug mode.
+//		+ debugMode bool: true => run client in debug mode. The client logs to stderr using logrus, the logger can be
+//		replaced afterwards with SetLogger.
 //		+ sync bool: true => client is in synchronous mode. The client will block until Create/Update/Delete processes
 //		are completely finished. It is safer to set this parameter to `true`.
 //		+ requestCheckTimeoutSecs int: Timeout (in second) for checking requests (for synchronous feature)
-//		+ delayIntervalMilliSecs int: delay (in MilliSecond) between requests when checking request (or retry 5xx, 424 error code)
-//		+ maxNumberOfRetries int: number of retries when server returns 5xx, 424 error code.
+//		+ delayIntervalMilliSecs int: delay (in MilliSecond) between requests when checking request. It is also the base
+//		delay of the exponential backoff when retrying 5xx, 424 error code.
+//		+ maxNumberOfRetries int: number of retries when server returns 5xx, 424 error code. 0 => 10 retries. With the
+//		default delays, a request is retried for at most about one minute.
+//
+//The retry behavior can be replaced afterwards with SetRetryPolicy. Requests are not rate limited on the client side
+//unless a limiter is set with SetRateLimiter. NewConfig offers the same settings as options.
 func NewConfiguration(apiURL string, uuid string, token string, debugMode, sync bool, requestCheckTimeoutSecs,
 	delayIntervalMilliSecs, maxNumberOfRetries int) *Config {
-	logLevel := logrus.InfoLevel
-	if debugMode {
-		logLevel = logrus.DebugLevel
-	}
-
-	logger := logrus.Logger{
-		Out:   os.Stderr,
-		Level: logLevel,
-		Formatter: &logrus.TextFormatter{
-			FullTimestamp: true,
-			DisableColors: false,
-		},
-	}
-
 	if requestCheckTimeoutSecs == 0 {
 		requestCheckTimeoutSecs = defaultCheckRequestTimeoutSecs
 	}
 	if delayIntervalMilliSecs == 0 {
 		delayIntervalMilliSecs = defaultDelayIntervalMilliSecs
 	}
-	if maxNumberOfRetries == 0 {
-		maxNumberOfRetries = defaultMaxNumberOfRetries
-	}
+	return NewConfig(
+		WithAPIURL(apiURL),
+		WithCredentials(uuid, token),
+		WithSync(sync),
+		WithLogger(newStderrLogger(debugMode)),
+		WithTimeouts(
+			time.Duration(requestCheckTimeoutSecs)*time.Second,
+			time.Duration(delayIntervalMilliSecs)*time.Millisecond,
+		),
+		WithMaxNumberOfRetries(maxNumberOfRetries),
+	)
+}
+
+//Option configures a Config created with NewConfig
+type Option func(*Config)
+
+//NewConfig creates a new config. Without options, the config uses the default API URL, http.DefaultClient,
+//asynchronous mode and a logger discarding all log entries. Credentials have to be set with WithCredentials.
+//
+//Failed requests are retried up to 10 times with a base delay of 500ms, doubled on every retry and capped at 10s.
+//A request is therefore retried for at most about one minute.
+func NewConfig(opts ...Option) *Config {
 	cfg := &Config{
-		apiURL:                  apiURL,
-		userUUID:                uuid,
-		apiToken:                token,
+		apiURL:                  defaultAPIURL,
 		userAgent:               "gsclient-go/" + version + " (" + runtime.GOOS + ")",
-		sync:                    sync,
 		httpClient:              http.DefaultClient,
-		logger:                  logger,
-		requestCheckTimeoutSecs: time.Duration(requestCheckTimeoutSecs) * time.Second,
-		delayInterval:           time.Duration(delayIntervalMilliSecs) * time.Millisecond,
-		maxNumberOfRetries:      maxNumberOfRetries,
+		logger:                  NoopLogger{},
+		requestCheckTimeoutSecs: defaultCheckRequestTimeoutSecs * time.Second,
+		delayInterval:           defaultDelayIntervalMilliSecs * time.Millisecond,
+		maxNumberOfRetries:      defaultMaxNumberOfRetries,
+		bulkConcurrency:         defaultBulkConcurrency,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.retryPolicy == nil {
+		cfg.retryPolicy = cfg.defaultRetryPolicy()
 	}
 	return cfg
 }
+
+//defaultRetryPolicy returns the retry policy used if none is set
+func (c *Config) defaultRetryPolicy() RetryPolicy {
+	return &ExponentialBackoffRetryPolicy{
+		MaxRetries: c.maxNumberOfRetries,
+		BaseDelay:  c.delayInterval,
+		MaxDelay:   defaultMaxRetryDelaySecs * time.Second,
+	}
+}
+
+//WithAPIURL sets the base URL of the API
+func WithAPIURL(apiURL string) Option {
+	return func(c *Config) {
+		if apiURL != "" {
+			c.apiURL = apiURL
+		}
+	}
+}
+
+//WithCredentials sets the UUID of the user and the API token
+func WithCredentials(uuid, token string) Option {
+	return func(c *Config) {
+		c.userUUID = uuid
+		c.apiToken = token
+	}
+}
+
+//WithLocationUUID sets the default location. It is used when a server, storage, network, IP address, ISO-image
+//or load balancer is created without a location.
+func WithLocationUUID(locationUUID string) Option {
+	return func(c *Config) {
+		c.locationUUID = locationUUID
+	}
+}
+
+//WithHTTPClient sets the HTTP client used to send requests. nil => http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Config) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		c.httpClient = httpClient
+	}
+}
+
+//WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Config) {
+		if userAgent != "" {
+			c.userAgent = userAgent
+		}
+	}
+}
+
+//WithSync sets the synchronous mode. sync=true => the client blocks until Create/Update/Delete processes
+//are completely finished.
+func WithSync(sync bool) Option {
+	return func(c *Config) {
+		c.sync = sync
+	}
+}
+
+//WithTimeouts sets the timeout for checking requests (for synchronous feature) and the delay between requests
+//when checking requests. A zero value keeps the default.
+func WithTimeouts(requestCheckTimeout, delayInterval time.Duration) Option {
+	return func(c *Config) {
+		if requestCheckTimeout > 0 {
+			c.requestCheckTimeoutSecs = requestCheckTimeout
+		}
+		if delayInterval > 0 {
+			c.delayInterval = delayInterval
+		}
+	}
+}
+
+//WithMaxNumberOfRetries sets the number of retries of the default retry policy. 0 => keep the default (10).
+func WithMaxNumberOfRetries(maxNumberOfRetries int) Option {
+	return func(c *Config) {
+		if maxNumberOfRetries > 0 {
+			c.maxNumberOfRetries = maxNumberOfRetries
+		}
+	}
+}
+
+//WithRetryPolicy sets the policy deciding whether and when failed requests are retried.
+//nil => the default ExponentialBackoffRetryPolicy is used.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Config) {
+		//the default policy is created by NewConfig, after all options have been applied
+		c.retryPolicy = policy
+	}
+}
+
+//WithRateLimiter sets the limiter shared by all requests of clients using the config
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Config) {
+		c.SetRateLimiter(limiter)
+	}
+}
+
+//WithMiddleware appends middlewares to the chain every request passes through
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Config) {
+		c.AddMiddleware(middlewares...)
+	}
+}
+
+//WithBulkConcurrency sets how many items bulk functions (e.g. GetServers) process at the same time. The default is 8.
+func WithBulkConcurrency(concurrency int) Option {
+	return func(c *Config) {
+		c.SetBulkConcurrency(concurrency)
+	}
+}
+
+//WithCache enables caching of responses, see SetCache
+func WithCache(options CacheOptions) Option {
+	return func(c *Config) {
+		c.SetCache(options)
+	}
+}
+
+//WithLogger sets the logger. nil => nothing is logged.
+func WithLogger(logger Logger) Option {
+	return func(c *Config) {
+		c.SetLogger(logger)
+	}
+}
+
+//ConfigFromEnv creates a new config from environment variables. Options passed to it are applied afterwards,
+//so they take precedence over the environment.
+//
+//- Environment variables:
+//		+ GRIDSCALE_UUID: UUID of user (required).
+//		+ GRIDSCALE_TOKEN: API token (required).
+//		+ GRIDSCALE_URL: base URL of API.
+//		+ GRIDSCALE_SYNC: true => client is in synchronous mode.
+//		+ GRIDSCALE_DEBUG: true => the client logs to stderr in debug mode.
+//		+ GRIDSCALE_REQUEST_CHECK_TIMEOUT_SECS: timeout (in second) for checking requests.
+//		+ GRIDSCALE_DELAY_INTERVAL_MILLISECS: delay (in MilliSecond) between requests when checking request.
+//		+ GRIDSCALE_MAX_NUMBER_OF_RETRIES: number of retries when server returns 5xx, 424, 429 error code.
+//		+ GRIDSCALE_LOCATION_UUID: default location.
+func ConfigFromEnv(opts ...Option) (*Config, error) {
+	envOpts, err := envOptions()
+	if err != nil {
+		return nil, err
+	}
+	cfg := NewConfig(append(envOpts, opts...)...)
+	if cfg.userUUID == "" || cfg.apiToken == "" {
+		return nil, fmt.Errorf("%s and %s are required", envUUID, envToken)
+	}
+	return cfg, nil
+}
+
+//envOptions turns the environment variables which are set into options
+func envOptions() ([]Option, error) {
+	var opts []Option
+	if value, ok := os.LookupEnv(envURL); ok {
+		opts = append(opts, WithAPIURL(value))
+	}
+	uuid, hasUUID := os.LookupEnv(envUUID)
+	token, hasToken := os.LookupEnv(envToken)
+	if hasUUID || hasToken {
+		opts = append(opts, func(c *Config) {
+			if hasUUID {
+				c.userUUID = uuid
+			}
+			if hasToken {
+				c.apiToken = token
+			}
+		})
+	}
+	if value, ok := os.LookupEnv(envLocationUUID); ok {
+		opts = append(opts, WithLocationUUID(value))
+	}
+	if value, ok := os.LookupEnv(envSync); ok {
+		sync, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is invalid: %v", envSync, err)
+		}
+		opts = append(opts, WithSync(sync))
+	}
+	if value, ok := os.LookupEnv(envDebug); ok {
+		debug, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is invalid: %v", envDebug, err)
+		}
+		if debug {
+			opts = append(opts, WithLogger(newStderrLogger(true)))
+		}
+	}
+	intEnvs := []struct {
+		name  string
+		apply func(int) Option
+	}{
+		{envRequestCheckTimeoutSecs, func(v int) Option { return WithTimeouts(time.Duration(v)*time.Second, 0) }},
+		{envDelayIntervalMilliSecs, func(v int) Option { return WithTimeouts(0, time.Duration(v)*time.Millisecond) }},
+		{envMaxNumberOfRetries, WithMaxNumberOfRetries},
+	}
+	for _, env := range intEnvs {
+		value, ok := os.LookupEnv(env.name)
+		if !ok {
+			continue
+		}
+		v, err := strconv.Atoi(value)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("%s is invalid: %q is not a positive number", env.name, value)
+		}
+		opts = append(opts, env.apply(v))
+	}
+	return opts, nil
+}
+
+//newStderrLogger creates the logrus logger writing to stderr which is used by NewConfiguration
+func newStderrLogger(debugMode bool) Logger {
+	logLevel := logrus.InfoLevel
+	if debugMode {
+		logLevel = logrus.DebugLevel
+	}
+	logger := logrus.New()
+	logger.Out = os.Stderr
+	logger.Level = logLevel
+	logger.Formatter = &logrus.TextFormatter{
+		FullTimestamp: true,
+		DisableColors: false,
+	}
+	return NewLogrusLogger(logger)
+}
+
+//SetRetryPolicy replaces the policy deciding whether and when failed requests are retried.
+//nil => the default ExponentialBackoffRetryPolicy is used.
+func (c *Config) SetRetryPolicy(policy RetryPolicy) {
+	if policy == nil {
+		policy = c.defaultRetryPolicy()
+	}
+	c.retryPolicy = policy
+}
+
+//SetRateLimiter sets the limiter shared by all requests of clients using this config. nil => no rate limiting.
+func (c *Config) SetRateLimiter(limiter RateLimiter) {
+	c.rateLimiter = limiter
+}
+
+//SetBulkConcurrency sets how many items bulk functions (e.g. GetServers) process at the same time.
+//Values below 1 => the default of 8 is used.
+func (c *Config) SetBulkConcurrency(concurrency int) {
+	if concurrency < 1 {
+		concurrency = defaultBulkConcurrency
+	}
+	c.bulkConcurrency = concurrency
+}
+
+//SetCache enables caching of responses of GET requests. Cached responses are returned until their TTL has expired,
+//afterwards they are revalidated with If-None-Match if the API has returned an ETag. Creating, updating or deleting
+//an object removes all cached responses of its object type. Clients using this config share the cache.
+func (c *Config) SetCache(options CacheOptions) {
+	c.cache = newResponseCache(options)
+}
+
+//SetLogger sets the logger used by clients using this config. nil => nothing is logged.
+func (c *Config) SetLogger(logger Logger) {
+	if logger == nil {
+		logger = NoopLogger{}
+	}
+	c.logger = logger
+}
+
+//AddMiddleware appends middlewares to the chain every request passes through.
+//The middleware added first is the outermost one, i.e. it sees the request first and the response last.
+func (c *Config) AddMiddleware(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
@@ -0,0 +1,161 @@
+package gsclient
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//setEnv sets environment variables and returns a function restoring the previous environment
+func setEnv(env map[string]string) func() {
+	previous := make(map[string]*string)
+	for key, value := range env {
+		if old, ok := os.LookupEnv(key); ok {
+			previous[key] = &old
+		} else {
+			previous[key] = nil
+		}
+		os.Setenv(key, value)
+	}
+	return func() {
+		for key, value := range previous {
+			if value == nil {
+				os.Unsetenv(key)
+			} else {
+				os.Setenv(key, *value)
+			}
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	cfg := NewConfig()
+	assert.Equal(t, defaultAPIURL, cfg.apiURL)
+	assert.Equal(t, http.DefaultClient, cfg.httpClient)
+	assert.Equal(t, NoopLogger{}, cfg.logger)
+	assert.False(t, cfg.sync)
+	assert.Equal(t, defaultCheckRequestTimeoutSecs*time.Second, cfg.requestCheckTimeoutSecs)
+	assert.Equal(t, defaultDelayIntervalMilliSecs*time.Millisecond, cfg.delayInterval)
+	assert.Equal(t, &ExponentialBackoffRetryPolicy{
+		MaxRetries: defaultMaxNumberOfRetries,
+		BaseDelay:  defaultDelayIntervalMilliSecs * time.Millisecond,
+		MaxDelay:   defaultMaxRetryDelaySecs * time.Second,
+	}, cfg.retryPolicy)
+	assert.Equal(t, defaultBulkConcurrency, cfg.bulkConcurrency)
+
+	httpClient := &http.Client{}
+	logger := &recordingLogger{}
+	limiter := NewTokenBucketRateLimiter(1, 1)
+	cfg = NewConfig(
+		WithAPIURL("http://localhost"),
+		WithCredentials("uuid", "token"),
+		WithHTTPClient(httpClient),
+		WithUserAgent("test-agent"),
+		WithSync(true),
+		WithTimeouts(time.Second, time.Millisecond),
+		WithMaxNumberOfRetries(3),
+		WithRateLimiter(limiter),
+		WithLogger(logger),
+		WithBulkConcurrency(20),
+	)
+	assert.Equal(t, "http://localhost", cfg.apiURL)
+	assert.Equal(t, "uuid", cfg.userUUID)
+	assert.Equal(t, "token", cfg.apiToken)
+	assert.Equal(t, httpClient, cfg.httpClient)
+	assert.Equal(t, "test-agent", cfg.userAgent)
+	assert.True(t, cfg.sync)
+	assert.Equal(t, time.Second, cfg.requestCheckTimeoutSecs)
+	assert.Equal(t, time.Millisecond, cfg.delayInterval)
+	assert.Equal(t, limiter, cfg.rateLimiter)
+	assert.Equal(t, logger, cfg.logger)
+	assert.Equal(t, 20, cfg.bulkConcurrency)
+	assert.Equal(t, &ExponentialBackoffRetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   defaultMaxRetryDelaySecs * time.Second,
+	}, cfg.retryPolicy)
+
+	policy := &ExponentialBackoffRetryPolicy{MaxRetries: 1}
+	cfg = NewConfig(WithRetryPolicy(policy), WithMaxNumberOfRetries(3))
+	assert.Equal(t, policy, cfg.retryPolicy)
+
+	cfg = NewConfig(WithBulkConcurrency(0))
+	assert.Equal(t, defaultBulkConcurrency, cfg.bulkConcurrency)
+}
+
+func TestNewConfiguration(t *testing.T) {
+	cfg := NewConfiguration("http://localhost", "uuid", "token", false, true, 0, 0, 0)
+	assert.Equal(t, "http://localhost", cfg.apiURL)
+	assert.Equal(t, "uuid", cfg.userUUID)
+	assert.Equal(t, "token", cfg.apiToken)
+	assert.True(t, cfg.sync)
+	assert.Equal(t, defaultCheckRequestTimeoutSecs*time.Second, cfg.requestCheckTimeoutSecs)
+	assert.Equal(t, defaultDelayIntervalMilliSecs*time.Millisecond, cfg.delayInterval)
+	assert.IsType(t, logrusLogger{}, cfg.logger)
+
+	cfg = NewConfiguration("http://localhost", "uuid", "token", true, false, 1, 100, 5)
+	assert.Equal(t, time.Second, cfg.requestCheckTimeoutSecs)
+	assert.Equal(t, 100*time.Millisecond, cfg.delayInterval)
+	assert.Equal(t, 5, cfg.retryPolicy.(*ExponentialBackoffRetryPolicy).MaxRetries)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	type testCase struct {
+		env      map[string]string
+		isFailed bool
+	}
+	testCases := []testCase{
+		{
+			env: map[string]string{
+				envUUID:                    dummyUUID,
+				envToken:                   "token",
+				envURL:                     "http://localhost",
+				envSync:                    "true",
+				envDebug:                   "false",
+				envRequestCheckTimeoutSecs: "10",
+				envDelayIntervalMilliSecs:  "200",
+				envMaxNumberOfRetries:      "7",
+			},
+			isFailed: false,
+		},
+		{
+			env:      map[string]string{envUUID: dummyUUID, envToken: ""},
+			isFailed: true,
+		},
+		{
+			env:      map[string]string{envUUID: dummyUUID, envToken: "token", envSync: "maybe"},
+			isFailed: true,
+		},
+		{
+			env:      map[string]string{envUUID: dummyUUID, envToken: "token", envMaxNumberOfRetries: "-1"},
+			isFailed: true,
+		},
+	}
+	for _, test := range testCases {
+		restore := setEnv(test.env)
+		cfg, err := ConfigFromEnv()
+		restore()
+		if test.isFailed {
+			assert.NotNil(t, err)
+			continue
+		}
+		assert.Nil(t, err, "ConfigFromEnv returned an error %v", err)
+		assert.Equal(t, dummyUUID, cfg.userUUID)
+		assert.Equal(t, "token", cfg.apiToken)
+		assert.Equal(t, "http://localhost", cfg.apiURL)
+		assert.True(t, cfg.sync)
+		assert.Equal(t, 10*time.Second, cfg.requestCheckTimeoutSecs)
+		assert.Equal(t, 200*time.Millisecond, cfg.delayInterval)
+		assert.Equal(t, 7, cfg.retryPolicy.(*ExponentialBackoffRetryPolicy).MaxRetries)
+	}
+
+	//options take precedence over the environment
+	restore := setEnv(map[string]string{envUUID: dummyUUID, envToken: "token", envSync: "false"})
+	defer restore()
+	cfg, err := ConfigFromEnv(WithSync(true))
+	assert.Nil(t, err, "ConfigFromEnv returned an error %v", err)
+	assert.True(t, cfg.sync)
+}
@@ -0,0 +1,107 @@
+package gsclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+//Sentinel errors which can be checked with errors.Is
+var (
+	//ErrNotFound is matched by errors returned when an object does not exist
+	ErrNotFound = errors.New("not found")
+
+	//ErrConflict is matched by errors returned when a request conflicts with the current state of an object
+	ErrConflict = errors.New("conflict")
+
+	//ErrInvalidArgument is matched by errors returned when an argument is invalid. No request is sent in that case.
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	//ErrTimeout is returned when waiting for an object or a request (in synchronous mode) takes too long
+	ErrTimeout = errors.New("timeout reached")
+
+	//ErrRequestFailed is matched by all errors returned by the API (see RequestError), and by asynchronous requests
+	//which ended as failed or cancelled (see RequestStatusError)
+	ErrRequestFailed = errors.New("request failed")
+)
+
+//ArgumentError is returned when arguments of a function are invalid. It matches ErrInvalidArgument when checked
+//with errors.Is.
+type ArgumentError struct {
+	//Names of the invalid arguments
+	Arguments []string
+
+	//Description of the problem
+	Message string
+}
+
+//newArgumentError creates an ArgumentError
+func newArgumentError(message string, arguments ...string) ArgumentError {
+	return ArgumentError{
+		Arguments: arguments,
+		Message:   message,
+	}
+}
+
+//Error just returns error as string
+func (e ArgumentError) Error() string {
+	return e.Message
+}
+
+//Is reports whether the target is ErrInvalidArgument
+func (e ArgumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+//RequestStatusError is returned when an asynchronous request ended as failed or cancelled. It matches
+//ErrRequestFailed when checked with errors.Is.
+type RequestStatusError struct {
+	//UUID of the request
+	RequestUUID string
+
+	//Final status of the request, e.g. "failed"
+	Status string
+
+	//Message returned by the API
+	Message string
+
+	//Time the request was created
+	CreateTime GSTime
+}
+
+//Error just returns error as string
+func (e RequestStatusError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = "no error message received from server"
+	}
+	return fmt.Sprintf("request %s %s (created at %s): %s", e.RequestUUID, e.Status, e.CreateTime, message)
+}
+
+//Is reports whether the target is ErrRequestFailed
+func (e RequestStatusError) Is(target error) bool {
+	return target == ErrRequestFailed
+}
+
+//ConcurrentModificationError is returned by the UpdateXxxFunc functions (e.g. UpdateServerFunc) when the object
+//has been changed by someone else on every attempt. It matches ErrConflict when checked with errors.Is.
+type ConcurrentModificationError struct {
+	//Type of the object, e.g. "server"
+	ObjectType string
+
+	//UUID of the object
+	ObjectUUID string
+
+	//Number of attempts made before giving up
+	Attempts int
+}
+
+//Error just returns error as string
+func (e ConcurrentModificationError) Error() string {
+	return fmt.Sprintf("%s %s has been changed concurrently, giving up after %d attempts", e.ObjectType, e.ObjectUUID,
+		e.Attempts)
+}
+
+//Is reports whether the target is ErrConflict
+func (e ConcurrentModificationError) Is(target error) bool {
+	return target == ErrConflict
+}
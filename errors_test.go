@@ -0,0 +1,99 @@
+package gsclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestError_Is(t *testing.T) {
+	type testCase struct {
+		statusCode int
+		target     error
+		expected   bool
+	}
+	testCases := []testCase{
+		{statusCode: http.StatusNotFound, target: ErrNotFound, expected: true},
+		{statusCode: http.StatusNotFound, target: ErrRequestFailed, expected: true},
+		{statusCode: http.StatusNotFound, target: ErrConflict, expected: false},
+		{statusCode: http.StatusConflict, target: ErrConflict, expected: true},
+		{statusCode: http.StatusConflict, target: ErrNotFound, expected: false},
+		{statusCode: http.StatusBadRequest, target: ErrRequestFailed, expected: true},
+		{statusCode: http.StatusBadRequest, target: ErrInvalidArgument, expected: false},
+		{statusCode: http.StatusInternalServerError, target: ErrTimeout, expected: false},
+	}
+	for _, test := range testCases {
+		err := fmt.Errorf("wrapped: %w", RequestError{StatusCode: test.statusCode})
+		assert.Equal(t, test.expected, errors.Is(err, test.target), "status code %d, target %v", test.statusCode, test.target)
+	}
+}
+
+func TestArgumentError_Is(t *testing.T) {
+	err := newArgumentError("'id' is required", "id")
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	assert.False(t, errors.Is(err, ErrRequestFailed))
+	assert.Equal(t, "'id' is required", err.Error())
+}
+
+func TestClient_RequestErrorDetails(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	var calls int32
+	uri := path.Join(apiServerBase, dummyUUID)
+	mux.HandleFunc(uri, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set(requestUUIDHeader, dummyRequestUUID)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client.cfg.retryPolicy = &ExponentialBackoffRetryPolicy{
+		MaxRetries: 2,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   time.Millisecond,
+	}
+	_, err := client.GetServer(emptyCtx, dummyUUID)
+	assert.True(t, errors.Is(err, ErrRequestFailed))
+	assert.False(t, errors.Is(err, ErrNotFound))
+	var requestError RequestError
+	if assert.True(t, errors.As(err, &requestError)) {
+		assert.Equal(t, http.StatusServiceUnavailable, requestError.StatusCode)
+		assert.Equal(t, http.MethodGet, requestError.Method)
+		assert.Equal(t, uri, requestError.URI)
+		assert.Equal(t, dummyRequestUUID, requestError.RequestUUID)
+		assert.Equal(t, int(atomic.LoadInt32(&calls)), requestError.Attempts)
+		assert.Contains(t, requestError.Error(), uri)
+	}
+}
+
+func TestClient_NotFoundError(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	mux.HandleFunc(path.Join(apiStorageBase, dummyUUID), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	_, err := client.GetStorage(emptyCtx, dummyUUID)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	mux.HandleFunc(apiTemplateBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, prepareTemplateListHTTPGet())
+	})
+	_, err = client.GetTemplateByName(emptyCtx, "does-not-exist")
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrRequestFailed))
+}
+
+func TestClient_InvalidArgumentError(t *testing.T) {
+	server, client, _ := setupTestClient(false)
+	defer server.Close()
+	_, err := client.GetServer(emptyCtx, "")
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	var argumentError ArgumentError
+	if assert.True(t, errors.As(err, &argumentError)) {
+		assert.Equal(t, []string{"id"}, argumentError.Arguments)
+	}
+}
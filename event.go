@@ -1,9 +1,24 @@
 package gsclient
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 )
 
+//defaultWatchEventsInterval is the default interval of polling the events in WatchEvents
+const defaultWatchEventsInterval = 10 * time.Second
+
+//EventOperator is an interface defining API of an event operator
+type EventOperator interface {
+	GetEventList(ctx context.Context, opts ...ListOptions) ([]Event, error)
+	EventsIter(ctx context.Context, yield func(Event) bool) error
+	WatchEvents(ctx context.Context, filter EventFilter) (<-chan EventProperties, error)
+}
+
 //EventList is JSON struct of a list of events
 type EventList struct {
 	//Array of events
@@ -49,16 +64,205 @@ type EventProperties struct {
 //GetEventList gets a list of events
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/EventGetAll
-func (c *Client) GetEventList() ([]Event, error) {
+func (c *Client) GetEventList(ctx context.Context, opts ...ListOptions) ([]Event, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiEventBase,
+		uri:    apiEventBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var events []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		events = append(events, Event{Properties: properties})
 	}
-	return events, err
+	return events, options.apply(&events)
+}
+
+//EventsIter calls yield for all events, in the order of the response. The response is decoded one event at a time
+//instead of being read into memory as a whole like in GetEventList. Iterating stops early when yield returns false
+//or the context is done.
+func (c *Client) EventsIter(ctx context.Context, yield func(Event) bool) error {
+	return c.iterate(ctx, apiEventBase, "events", func(_ string, dec *json.Decoder) (bool, error) {
+		var event Event
+		if err := dec.Decode(&event.Properties); err != nil {
+			return false, err
+		}
+		return yield(event), nil
+	})
+}
+
+//EventFilter selects the events delivered by WatchEvents. Lists which are empty match all events, otherwise an
+//event has to match one of their entries.
+type EventFilter struct {
+	//Object types, e.g. "server" or "storage". They are compared case-insensitively, ignoring "_" and "-".
+	ObjectTypes []string
+
+	//UUIDs of objects
+	ObjectUUIDs []string
+
+	//Types of change, compared case-insensitively
+	Activities []string
+
+	//Request statuses, e.g. "failed", compared case-insensitively
+	RequestStatuses []string
+
+	//UUIDs of the users who have triggered the events
+	UserUUIDs []string
+
+	//Only events at or after this time are delivered. To resume watching after a restart, set it to the timestamp
+	//of the last event processed. Timestamps of the API have a precision of one second, so the events of that second
+	//are delivered again, including the last event processed. Zero => only events which are new since watching has
+	//started are delivered.
+	Since time.Time
+
+	//Only events until this time are delivered. Watching ends once it has passed. Zero => no end.
+	Until time.Time
+
+	//Interval of polling the events, default 10s
+	Interval time.Duration
+}
+
+//Matches reports whether an event matches the filter
+func (f EventFilter) Matches(event EventProperties) bool {
+	matches := func(list []string, value string, equal func(a, b string) bool) bool {
+		if len(list) == 0 {
+			return true
+		}
+		for _, item := range list {
+			if equal(item, value) {
+				return true
+			}
+		}
+		return false
+	}
+	sameType := func(a, b string) bool {
+		return normalizeObjectType(a) == normalizeObjectType(b)
+	}
+	equal := func(a, b string) bool {
+		return a == b
+	}
+	return matches(f.ObjectTypes, event.ObjectType, sameType) &&
+		matches(f.ObjectUUIDs, event.ObjectUUID, equal) &&
+		matches(f.Activities, event.Activity, strings.EqualFold) &&
+		matches(f.RequestStatuses, event.RequestStatus, strings.EqualFold) &&
+		matches(f.UserUUIDs, event.UserUUID, equal) &&
+		(f.Since.IsZero() || !event.Timestamp.Before(f.Since)) &&
+		(f.Until.IsZero() || !event.Timestamp.After(f.Until))
+}
+
+//WatchEvents polls the events and delivers the new ones matching the filter on the returned channel, oldest first.
+//Every event is delivered once, even if the API returns it repeatedly. The channel is closed when the context is
+//done, or when the end of the time window of the filter has passed.
+//
+//Errors of polling are logged, and the events are polled again in the next interval. An error is returned only
+//if the filter is invalid.
+func (c *Client) WatchEvents(ctx context.Context, filter EventFilter) (<-chan EventProperties, error) {
+	if filter.Interval < 0 {
+		return nil, newArgumentError("'Interval' cannot be negative", "filter")
+	}
+	if !filter.Until.IsZero() && filter.Until.Before(filter.Since) {
+		return nil, newArgumentError("'Until' cannot be before 'Since'", "filter")
+	}
+	if filter.Interval == 0 {
+		filter.Interval = defaultWatchEventsInterval
+	}
+	events := make(chan EventProperties)
+	go func() {
+		defer close(events)
+		ctx := WithoutCache(ctx)
+		var cursor eventCursor
+		//without start time, the events which exist already are skipped
+		skip := filter.Since.IsZero()
+		ticker := time.NewTicker(filter.Interval)
+		defer ticker.Stop()
+		for {
+			polled := time.Now()
+			list, err := c.GetEventList(ctx)
+			if err != nil && ctx.Err() == nil {
+				c.cfg.logger.Warn("Polling the events failed", Fields{"error": err})
+			}
+			newEvents := cursor.next(list)
+			if err == nil && skip {
+				skip = false
+				newEvents = nil
+			}
+			for _, event := range newEvents {
+				if !filter.Matches(event) {
+					continue
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !filter.Until.IsZero() && polled.After(filter.Until) {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return events, nil
+}
+
+//eventCursor remembers which events have been seen, so that events read repeatedly are processed once
+type eventCursor struct {
+	//Timestamp of the latest event seen
+	last GSTime
+
+	//Keys of the events seen at the latest timestamp
+	seen map[string]bool
+}
+
+//eventKey identifies an event
+func eventKey(event EventProperties) string {
+	return event.RequestUUID + "/" + event.ObjectUUID + "/" + event.Timestamp.Format(time.RFC3339Nano)
+}
+
+//clone returns a copy of the cursor
+func (cursor eventCursor) clone() eventCursor {
+	seen := make(map[string]bool, len(cursor.seen))
+	for key := range cursor.seen {
+		seen[key] = true
+	}
+	return eventCursor{last: cursor.last, seen: seen}
+}
+
+//next returns the events which have not been seen yet, sorted by their timestamp, and marks them as seen.
+//Events older than the latest event seen are skipped.
+func (cursor *eventCursor) next(events []Event) []EventProperties {
+	var result []EventProperties
+	for _, event := range events {
+		properties := event.Properties
+		if properties.Timestamp.Before(cursor.last.Time) ||
+			(properties.Timestamp.Equal(cursor.last.Time) && cursor.seen[eventKey(properties)]) {
+			continue
+		}
+		result = append(result, properties)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp.Before(result[j].Timestamp.Time)
+	})
+	for _, properties := range result {
+		if properties.Timestamp.After(cursor.last.Time) {
+			cursor.last = properties.Timestamp
+			cursor.seen = nil
+		}
+		if cursor.seen == nil {
+			cursor.seen = make(map[string]bool)
+		}
+		cursor.seen[eventKey(properties)] = true
+	}
+	return result
 }
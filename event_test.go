@@ -1,11 +1,15 @@
 package gsclient
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestClient_GetEventList(t *testing.T) {
@@ -16,7 +20,7 @@ func TestClient_GetEventList(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		fmt.Fprint(w, prepareEventListHTTPGet())
 	})
-	response, err := client.GetEventList()
+	response, err := client.GetEventList(emptyCtx)
 	assert.Nil(t, err, "GetEventList returned an error %v", err)
 	assert.Equal(t, 1, len(response))
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockEvent()), fmt.Sprintf("%v", response))
@@ -42,3 +46,114 @@ func prepareEventListHTTPGet() string {
 	res, _ := json.Marshal(event.Properties)
 	return fmt.Sprintf(`{"events": [%s]}`, string(res))
 }
+
+func TestEventFilter_Matches(t *testing.T) {
+	event := getMockEvent().Properties
+	testCases := []struct {
+		filter  EventFilter
+		matches bool
+	}{
+		{EventFilter{}, true},
+		{EventFilter{ObjectTypes: []string{"server", "TYPE"}}, true},
+		{EventFilter{ObjectTypes: []string{"server"}}, false},
+		{EventFilter{ObjectUUIDs: []string{dummyUUID}, UserUUIDs: []string{dummyUUID}}, true},
+		{EventFilter{UserUUIDs: []string{"other"}}, false},
+		{EventFilter{Activities: []string{"Sent"}, RequestStatuses: []string{"active"}}, true},
+		{EventFilter{RequestStatuses: []string{"failed"}}, false},
+		{EventFilter{Since: dummyTime.Add(-time.Second)}, true},
+		{EventFilter{Since: dummyTime.Time}, true},
+		{EventFilter{Since: dummyTime.Add(time.Second)}, false},
+		{EventFilter{Until: dummyTime.Time}, true},
+		{EventFilter{Until: dummyTime.Add(-time.Second)}, false},
+	}
+	for i, test := range testCases {
+		assert.Equal(t, test.matches, test.filter.Matches(event), "test case %d", i)
+	}
+}
+
+func TestClient_WatchEvents(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	var mu sync.Mutex
+	var events []EventProperties
+	addEvent := func(requestUUID, status string, seconds int) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, EventProperties{
+			RequestUUID:   requestUUID,
+			RequestStatus: status,
+			Timestamp:     GSTime{dummyTime.Add(time.Duration(seconds) * time.Second)},
+		})
+	}
+	mux.HandleFunc(apiEventBase, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(EventList{List: events})
+	})
+	receive := func(watched <-chan EventProperties, n int) []string {
+		var requestUUIDs []string
+		for i := 0; i < n; i++ {
+			select {
+			case event := <-watched:
+				requestUUIDs = append(requestUUIDs, event.RequestUUID)
+			case <-time.After(time.Second):
+				t.Fatal("no event received")
+			}
+		}
+		return requestUUIDs
+	}
+	addEvent("old", "failed", 0)
+
+	//events which exist already are skipped without start time
+	ctx, cancel := context.WithCancel(emptyCtx)
+	watched, err := client.WatchEvents(ctx, EventFilter{RequestStatuses: []string{"failed"}, Interval: 10 * time.Millisecond})
+	assert.Nil(t, err)
+	time.Sleep(50 * time.Millisecond)
+	addEvent("a", "failed", 2)
+	addEvent("b", "done", 2)
+	addEvent("c", "failed", 1)
+	assert.Equal(t, []string{"c", "a"}, receive(watched, 2))
+	addEvent("d", "failed", 3)
+	assert.Equal(t, []string{"d"}, receive(watched, 1))
+	cancel()
+	for range watched {
+	}
+
+	//resumes at the timestamp of the last event processed, so that "b" is not lost if "a" has been processed
+	//before the restart, and stops once the end of the time window has passed
+	watched, err = client.WatchEvents(emptyCtx, EventFilter{
+		Since:    dummyTime.Add(2 * time.Second),
+		Until:    time.Now().Add(100 * time.Millisecond),
+		Interval: 10 * time.Millisecond,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "d"}, receive(watched, 3))
+	select {
+	case _, ok := <-watched:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("channel not closed")
+	}
+
+	_, err = client.WatchEvents(emptyCtx, EventFilter{Interval: -time.Second})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	_, err = client.WatchEvents(emptyCtx, EventFilter{Since: dummyTime.Time, Until: dummyTime.Add(-time.Second)})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestEventCursor(t *testing.T) {
+	event := func(requestUUID string, seconds int) Event {
+		return Event{Properties: EventProperties{RequestUUID: requestUUID, Timestamp: GSTime{dummyTime.Add(time.Duration(seconds) * time.Second)}}}
+	}
+	requestUUIDs := func(events []EventProperties) []string {
+		var result []string
+		for _, event := range events {
+			result = append(result, event.RequestUUID)
+		}
+		return result
+	}
+	var cursor eventCursor
+	assert.Equal(t, []string{"a", "c", "b"}, requestUUIDs(cursor.next([]Event{event("c", 2), event("a", 1), event("b", 2)})))
+	assert.Empty(t, cursor.next([]Event{event("a", 1), event("b", 2), event("c", 2)}))
+	assert.Equal(t, []string{"d", "e"}, requestUUIDs(cursor.next([]Event{event("b", 2), event("d", 2), event("e", 3), event("x", 0)})))
+}
@@ -2,24 +2,21 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"github.com/gridscale/gsclient-go"
 	log "github.com/sirupsen/logrus"
 	"os"
 )
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -39,7 +36,7 @@ func main() {
 		},
 	}
 	//Create a new firewall
-	cfw, err := client.CreateFirewall(fwRequest)
+	cfw, _, err := client.CreateFirewall(ctx, fwRequest)
 	if err != nil {
 		log.Error("Create firewall has failed with error", err)
 		return
@@ -47,7 +44,7 @@ func main() {
 	log.WithFields(log.Fields{"Firewall_uuid": cfw.ObjectUUID}).Info("Firewall successfully created")
 	log.Info("Update firewall: Press 'Enter' to continue...")
 	defer func() {
-		err := client.DeleteFirewall(cfw.ObjectUUID)
+		_, err := client.DeleteFirewall(ctx, cfw.ObjectUUID)
 		if err != nil {
 			log.Error("Delete firewall has failed with error", err)
 			return
@@ -57,24 +54,24 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Get a firewall to update
-	fw, err := client.GetFirewall(cfw.ObjectUUID)
+	fw, err := client.GetFirewall(ctx, cfw.ObjectUUID)
 	if err != nil {
 		log.Errorf("Get firewall %s has failed with error %v", cfw.ObjectUUID, err)
 		return
 	}
 	fwUpdateRequest := gsclient.FirewallUpdateRequest{
-		Name:   "Updated name",
-		Labels: fw.Properties.Labels,
+		Name:   gsclient.String("Updated name"),
+		Labels: &fw.Properties.Labels,
 		Rules:  &fw.Properties.Rules,
 	}
-	err = client.UpdateFirewall(fw.Properties.ObjectUUID, fwUpdateRequest)
+	_, err = client.UpdateFirewall(ctx, fw.Properties.ObjectUUID, fwUpdateRequest)
 	if err != nil {
 		log.Error("Update firewall has failed with error", err)
 		return
 	}
 
 	//Get firewall events
-	events, err := client.GetFirewallEventList(fw.Properties.ObjectUUID)
+	events, err := client.GetFirewallEventList(ctx, fw.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Get firewall's events has failed with error", err)
 		return
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"github.com/gridscale/gsclient-go"
 	log "github.com/sirupsen/logrus"
 	"os"
@@ -10,18 +11,14 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -34,14 +31,14 @@ func main() {
 		LocationUUID: locationUUID,
 	}
 	//Create new IP
-	ipc, err := client.CreateIP(ipRequest)
+	ipc, _, err := client.CreateIP(ctx, ipRequest)
 	if err != nil {
 		log.Error("Create IP address has failed with error", err)
 		return
 	}
 	log.WithFields(log.Fields{"ip_uuid": ipc.ObjectUUID}).Info("IP address successfully created")
 	defer func() {
-		err := client.DeleteIP(ipc.ObjectUUID)
+		_, err := client.DeleteIP(ctx, ipc.ObjectUUID)
 		if err != nil {
 			log.Error("Delete IP address has failed with error", err)
 			return
@@ -50,7 +47,7 @@ func main() {
 
 		log.Info("Get deleted IP address: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		ips, err := client.GetDeletedIPs()
+		ips, err := client.GetDeletedIPs(ctx)
 		if err != nil {
 			log.Error("Get delete IP address has failed with error", err)
 			return
@@ -64,18 +61,18 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Get IP to update
-	ip, err := client.GetIP(ipc.ObjectUUID)
+	ip, err := client.GetIP(ctx, ipc.ObjectUUID)
 	if err != nil {
 		log.Error("Get IP address has failed with error", err)
 		return
 	}
 	updateRequest := gsclient.IPUpdateRequest{
-		Name:       "Updated IP address",
-		Failover:   ip.Properties.Failover,
-		ReverseDNS: ip.Properties.ReverseDNS,
-		Labels:     ip.Properties.Labels,
+		Name:       gsclient.String("Updated IP address"),
+		Failover:   &ip.Properties.Failover,
+		ReverseDNS: &ip.Properties.ReverseDNS,
+		Labels:     &ip.Properties.Labels,
 	}
-	err = client.UpdateIP(ip.Properties.ObjectUUID, updateRequest)
+	_, err = client.UpdateIP(ctx, ip.Properties.ObjectUUID, updateRequest)
 	if err != nil {
 		log.Error("Update IP address has failed with error", err)
 		return
@@ -84,7 +81,7 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Get IP address events
-	response, err := client.GetIPEventList(ip.Properties.ObjectUUID)
+	response, err := client.GetIPEventList(ctx, ip.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Get IP address events has failed with error", err)
 		return
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"github.com/gridscale/gsclient-go"
 	"github.com/sirupsen/logrus"
 	"os"
@@ -10,18 +11,14 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(logrus.StandardLogger())),
 	)
+	if err != nil {
+		logrus.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	logrus.Info("gridscale client configured")
 
@@ -33,7 +30,7 @@ func main() {
 		SourceURL:    "http://tinycorelinux.net/10.x/x86/release/TinyCore-current.iso",
 		LocationUUID: locationUUID,
 	}
-	cIso, err := client.CreateISOImage(isoRequest)
+	cIso, _, err := client.CreateISOImage(ctx, isoRequest)
 	if err != nil {
 		logrus.Error("Create ISO-image has failed with error", err)
 		return
@@ -41,7 +38,7 @@ func main() {
 	logrus.WithFields(logrus.Fields{"isoimage_uuid": cIso.ObjectUUID}).Info("ISO Image successfully created")
 	defer func() {
 		//Delete ISO-image
-		err := client.DeleteISOImage(cIso.ObjectUUID)
+		_, err := client.DeleteISOImage(ctx, cIso.ObjectUUID)
 		if err != nil {
 			logrus.Error("Delete ISO-image has failed with error", err)
 			return
@@ -50,7 +47,7 @@ func main() {
 
 		logrus.Info("Get deleted ISO-images: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		isoImages, err := client.GetDeletedISOImages()
+		isoImages, err := client.GetDeletedISOImages(ctx)
 		if err != nil {
 			logrus.Error("Get deleted ISO-images has failed with error", err)
 			return
@@ -64,17 +61,17 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Get ISO-image to update
-	iso, err := client.GetISOImage(cIso.ObjectUUID)
+	iso, err := client.GetISOImage(ctx, cIso.ObjectUUID)
 	if err != nil {
 		logrus.Error("Get ISO-image has failed with error", err)
 		return
 	}
 
 	isoUpdateRequest := gsclient.ISOImageUpdateRequest{
-		Name:   "updated ISO",
-		Labels: iso.Properties.Labels,
+		Name:   gsclient.String("updated ISO"),
+		Labels: &iso.Properties.Labels,
 	}
-	err = client.UpdateISOImage(iso.Properties.ObjectUUID, isoUpdateRequest)
+	_, err = client.UpdateISOImage(ctx, iso.Properties.ObjectUUID, isoUpdateRequest)
 	if err != nil {
 		logrus.Error("Update ISO-image has failed with error", err)
 		return
@@ -82,7 +79,7 @@ func main() {
 	logrus.WithFields(logrus.Fields{"isoimage_uuid": iso.Properties.ObjectUUID}).Info("ISO image successfully updated")
 
 	//get ISO-image's events
-	events, err := client.GetISOImageEventList(iso.Properties.ObjectUUID)
+	events, err := client.GetISOImageEventList(ctx, iso.Properties.ObjectUUID)
 	if err != nil {
 		logrus.Error("Get ISO-image's events has failed with error", err)
 		return
@@ -2,31 +2,28 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"github.com/gridscale/gsclient-go"
 	log "github.com/sirupsen/logrus"
 	"os"
 )
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
 	log.Info("Create label: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
-	_, err := client.CreateLabel(gsclient.LabelCreateRequest{
+	_, _, err = client.CreateLabel(ctx, gsclient.LabelCreateRequest{
 		Label: "go-client-label",
 	})
 	if err != nil {
@@ -35,7 +32,7 @@ func main() {
 	}
 	log.Info("Label successfully created")
 	defer func() {
-		err := client.DeleteLabel("go-client-label")
+		_, err := client.DeleteLabel(ctx, "go-client-label")
 		if err != nil {
 			log.Error("Delete label has failed with error", err)
 			return
@@ -45,7 +42,7 @@ func main() {
 	log.Info("Retrieve labels: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
-	labels, err := client.GetLabelList()
+	labels, err := client.GetLabelList(ctx)
 	if err != nil {
 		log.Error("Retrieve labels has failed with error", err)
 		return
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"github.com/gridscale/gsclient-go"
 	log "github.com/sirupsen/logrus"
 	"os"
@@ -10,31 +11,27 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
 	log.Info("Create IPs and loadbalancer: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	// required to create IPv6 and IPv4 to create LB
-	ipv4, _ := client.CreateIP(gsclient.IPCreateRequest{
+	ipv4, _, _ := client.CreateIP(ctx, gsclient.IPCreateRequest{
 		Family:       gsclient.IPv4Type,
 		LocationUUID: locationUUID,
 	})
 	log.Info("IPv4 has been created")
 
-	ipv6, _ := client.CreateIP(gsclient.IPCreateRequest{
+	ipv6, _, _ := client.CreateIP(ctx, gsclient.IPCreateRequest{
 		Family:       gsclient.IPv6Type,
 		LocationUUID: locationUUID,
 	})
@@ -67,7 +64,7 @@ func main() {
 		Labels: labels,
 	}
 
-	clb, err := client.CreateLoadBalancer(lbRequest)
+	clb, _, err := client.CreateLoadBalancer(ctx, lbRequest)
 	if err != nil {
 		log.Fatal("Create loadbalancer has failed with error", err)
 	}
@@ -75,7 +72,7 @@ func main() {
 		"Loadbalancer_uuid": clb.ObjectUUID}).Info("Loadbalancer successfully created")
 
 	// Get the loadbalacer to update some settings
-	glb, err := client.GetLoadBalancer(clb.ObjectUUID)
+	glb, err := client.GetLoadBalancer(ctx, clb.ObjectUUID)
 	if err != nil {
 		log.Fatal("Get loadbalancer has failed with error", err)
 	}
@@ -83,13 +80,13 @@ func main() {
 	log.Info("Update loadbalacer: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	lbUpdateRequest := gsclient.LoadBalancerUpdateRequest{
-		Name:                "go-client-lb233",
-		Algorithm:           gsclient.LoadbalancerRoundrobinAlg,
-		LocationUUID:        glb.Properties.LocationUUID,
-		ListenIPv6UUID:      glb.Properties.ListenIPv6UUID,
-		ListenIPv4UUID:      glb.Properties.ListenIPv4UUID,
-		RedirectHTTPToHTTPS: glb.Properties.RedirectHTTPToHTTPS,
-		ForwardingRules: []gsclient.ForwardingRule{
+		Name:                gsclient.String("go-client-lb233"),
+		Algorithm:           &gsclient.LoadbalancerRoundrobinAlg,
+		LocationUUID:        &glb.Properties.LocationUUID,
+		ListenIPv6UUID:      &glb.Properties.ListenIPv6UUID,
+		ListenIPv4UUID:      &glb.Properties.ListenIPv4UUID,
+		RedirectHTTPToHTTPS: &glb.Properties.RedirectHTTPToHTTPS,
+		ForwardingRules: &[]gsclient.ForwardingRule{
 			{
 				LetsencryptSSL: nil,
 				ListenPort:     443,
@@ -97,10 +94,10 @@ func main() {
 				TargetPort:     443,
 			},
 		},
-		BackendServers: glb.Properties.BackendServers,
-		Labels:         labels,
+		BackendServers: &glb.Properties.BackendServers,
+		Labels:         &labels,
 	}
-	err = client.UpdateLoadBalancer(glb.Properties.ObjectUUID, lbUpdateRequest)
+	_, err = client.UpdateLoadBalancer(ctx, glb.Properties.ObjectUUID, lbUpdateRequest)
 
 	if err != nil {
 		log.Fatal("Update loadbalancer has failed with error", err)
@@ -112,7 +109,7 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Get loadbalancer events
-	response, err := client.GetLoadBalancerEventList(glb.Properties.ObjectUUID)
+	response, err := client.GetLoadBalancerEventList(ctx, glb.Properties.ObjectUUID)
 	if err != nil {
 		log.Fatal("Events loadbalancer has failed with error", err)
 	}
@@ -125,20 +122,20 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	// finallly clean up delete IPs and loadbalancer
-	err = client.DeleteLoadBalancer(glb.Properties.ObjectUUID)
+	_, err = client.DeleteLoadBalancer(ctx, glb.Properties.ObjectUUID)
 	if err != nil {
 		log.Fatal("Delete loadbalancer has failed with error", err)
 	}
 	log.WithFields(log.Fields{
 		"Loadbalancer_uuid": glb.Properties.ObjectUUID}).Info("Loadbalancer successfully deleted")
 
-	err = client.DeleteIP(ipv4.ObjectUUID)
+	_, err = client.DeleteIP(ctx, ipv4.ObjectUUID)
 	if err != nil {
 		log.Fatal("Delete ipv4 has failed with error", err)
 	}
 	log.Info("IPv4 successfully deleted")
 
-	err = client.DeleteIP(ipv6.ObjectUUID)
+	_, err = client.DeleteIP(ctx, ipv6.ObjectUUID)
 	if err != nil {
 		log.Fatal("Delete ipv6 has failed with error", err)
 	}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"os"
 
 	"github.com/gridscale/gsclient-go"
@@ -11,18 +12,14 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -33,7 +30,7 @@ func main() {
 		Name:         "go-client-network",
 		LocationUUID: locationUUID,
 	}
-	cnetwork, err := client.CreateNetwork(networkRequest)
+	cnetwork, _, err := client.CreateNetwork(ctx, networkRequest)
 	if err != nil {
 		log.Error("Create network has failed with error", err)
 		return
@@ -43,7 +40,7 @@ func main() {
 	}).Info("Network successfully created")
 	defer func() {
 		//delete network
-		err := client.DeleteNetwork(cnetwork.ObjectUUID)
+		_, err := client.DeleteNetwork(ctx, cnetwork.ObjectUUID)
 		if err != nil {
 			log.Error("Delete network has failed with error", err)
 			return
@@ -52,7 +49,7 @@ func main() {
 
 		log.Info("Get deleted networks: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		networks, err := client.GetDeletedNetworks()
+		networks, err := client.GetDeletedNetworks(ctx)
 		if err != nil {
 			log.Error("Get deleted networks has failed with error", err)
 			return
@@ -63,7 +60,7 @@ func main() {
 	}()
 
 	//Get network to update
-	net, err := client.GetNetwork(cnetwork.ObjectUUID)
+	net, err := client.GetNetwork(ctx, cnetwork.ObjectUUID)
 	if err != nil {
 		log.Error("Create network has failed ")
 		return
@@ -73,9 +70,9 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	netUpdateRequest := gsclient.NetworkUpdateRequest{
-		Name: "Updated network",
+		Name: gsclient.String("Updated network"),
 	}
-	err = client.UpdateNetwork(net.Properties.ObjectUUID, netUpdateRequest)
+	_, err = client.UpdateNetwork(ctx, net.Properties.ObjectUUID, netUpdateRequest)
 	if err != nil {
 		log.Error("Update network has failed with error", err)
 		return
@@ -87,7 +84,7 @@ func main() {
 	log.Info("Retrieve network's events: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//get network's events
-	events, err := client.GetNetworkEventList(net.Properties.ObjectUUID)
+	events, err := client.GetNetworkEventList(ctx, net.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Get network's events has failed with error", err)
 		return
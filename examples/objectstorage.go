@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"os"
 
 	"github.com/gridscale/gsclient-go"
@@ -9,25 +10,21 @@ import (
 )
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
 	log.Info("Create object storage access key: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
-	cobj, err := client.CreateObjectStorageAccessKey()
+	cobj, _, err := client.CreateObjectStorageAccessKey(ctx)
 	if err != nil {
 		log.Error("Create object storage access key has failed with error", err)
 		return
@@ -37,7 +34,7 @@ func main() {
 	}).Info("Create access key successfully")
 	defer func() {
 		//Delete access key
-		err := client.DeleteObjectStorageAccessKey(cobj.AccessKey.AccessKey)
+		_, err := client.DeleteObjectStorageAccessKey(ctx, cobj.AccessKey.AccessKey)
 		if err != nil {
 			log.Error("Delete access key has failed with error", err)
 			return
@@ -47,7 +44,7 @@ func main() {
 
 	log.Info("Get object storage access key: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
-	key, err := client.GetObjectStorageAccessKey(cobj.AccessKey.AccessKey)
+	key, err := client.GetObjectStorageAccessKey(ctx, cobj.AccessKey.AccessKey)
 	if err != nil {
 		log.Error("Retrieve object storage access key has failed with error", err)
 		return
@@ -58,7 +55,7 @@ func main() {
 
 	log.Info("Get buckets: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
-	buckets, err := client.GetObjectStorageBucketList()
+	buckets, err := client.GetObjectStorageBucketList(ctx)
 	if err != nil {
 		log.Error("Retrieve buckets has failed with error", err)
 		return
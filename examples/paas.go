@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"github.com/gridscale/gsclient-go"
 	log "github.com/sirupsen/logrus"
 	"os"
@@ -10,18 +11,14 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -29,7 +26,7 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Get template for creating paas
-	paasTemplates, err := client.GetPaaSTemplateList()
+	paasTemplates, err := client.GetPaaSTemplateList(ctx)
 	if err != nil {
 		log.Error("Get PaaS templates has failed with error", err)
 		return
@@ -40,7 +37,7 @@ func main() {
 		Name:         "go-client-security-zone",
 		LocationUUID: locationUUID,
 	}
-	cSCZ, err := client.CreatePaaSSecurityZone(secZoneRequest)
+	cSCZ, _, err := client.CreatePaaSSecurityZone(ctx, secZoneRequest)
 	if err != nil {
 		log.Error("Create security zone has failed with error", err)
 		return
@@ -49,7 +46,7 @@ func main() {
 		"securityzone_uuid": cSCZ.ObjectUUID,
 	}).Info("Security zone successfully created")
 	defer func() {
-		err := client.DeletePaaSSecurityZone(cSCZ.ObjectUUID)
+		_, err := client.DeletePaaSSecurityZone(ctx, cSCZ.ObjectUUID)
 		if err != nil {
 			log.Error("Delete security zone has failed with error", err)
 			return
@@ -63,7 +60,7 @@ func main() {
 		PaaSServiceTemplateUUID: paasTemplates[0].Properties.ObjectUUID,
 		PaaSSecurityZoneUUID:    cSCZ.ObjectUUID,
 	}
-	cPaaS, err := client.CreatePaaSService(paasRequest)
+	cPaaS, _, err := client.CreatePaaSService(ctx, paasRequest)
 	if err != nil {
 		log.Error("Create PaaS service has failed with error", err)
 		return
@@ -72,7 +69,7 @@ func main() {
 		"paas_uuid": cPaaS.ObjectUUID,
 	}).Info("PaaS service create successfully")
 	defer func() {
-		err := client.DeletePaaSService(cPaaS.ObjectUUID)
+		_, err := client.DeletePaaSService(ctx, cPaaS.ObjectUUID)
 		if err != nil {
 			log.Error("Delete PaaS service has failed with error", err)
 			return
@@ -81,7 +78,7 @@ func main() {
 
 		log.Info("Get deleted PaaS services: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		paasServices, err := client.GetDeletedPaaSServices()
+		paasServices, err := client.GetDeletedPaaSServices(ctx)
 		if err != nil {
 			log.Error("Get deleted PaaS services has failed with error", err)
 			return
@@ -95,18 +92,18 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Get a security zone to update
-	secZone, err := client.GetPaaSSecurityZone(cSCZ.ObjectUUID)
+	secZone, err := client.GetPaaSSecurityZone(ctx, cSCZ.ObjectUUID)
 	if err != nil {
 		log.Error("Get security zone has failed with error", err)
 		return
 	}
 	secZoneUpdateRequest := gsclient.PaaSSecurityZoneUpdateRequest{
-		Name:                 "updated security zone",
-		LocationUUID:         secZone.Properties.LocationUUID,
-		PaaSSecurityZoneUUID: secZone.Properties.ObjectUUID,
+		Name:                 gsclient.String("updated security zone"),
+		LocationUUID:         &secZone.Properties.LocationUUID,
+		PaaSSecurityZoneUUID: &secZone.Properties.ObjectUUID,
 	}
 	//Update security zone
-	err = client.UpdatePaaSSecurityZone(secZone.Properties.ObjectUUID, secZoneUpdateRequest)
+	_, err = client.UpdatePaaSSecurityZone(ctx, secZone.Properties.ObjectUUID, secZoneUpdateRequest)
 	if err != nil {
 		log.Error("Update security zone has failed with error", err)
 		return
@@ -114,7 +111,7 @@ func main() {
 	log.Info("Security Zone successfully updated")
 
 	//Get a PaaS service to update
-	paas, err := client.GetPaaSService(cPaaS.ObjectUUID)
+	paas, err := client.GetPaaSService(ctx, cPaaS.ObjectUUID)
 	if err != nil {
 		log.Error("Get PaaS service has failed with error", err)
 		return
@@ -122,12 +119,12 @@ func main() {
 
 	//Update PaaS service
 	paasUpdateRequest := gsclient.PaaSServiceUpdateRequest{
-		Name:           "updated paas",
-		Labels:         paas.Properties.Labels,
-		Parameters:     paas.Properties.Parameters,
-		ResourceLimits: paas.Properties.ResourceLimits,
+		Name:           gsclient.String("updated paas"),
+		Labels:         &paas.Properties.Labels,
+		Parameters:     &paas.Properties.Parameters,
+		ResourceLimits: &paas.Properties.ResourceLimits,
 	}
-	err = client.UpdatePaaSService(paas.Properties.ObjectUUID, paasUpdateRequest)
+	_, err = client.UpdatePaaSService(ctx, paas.Properties.ObjectUUID, paasUpdateRequest)
 	if err != nil {
 		log.Error("Update PaaS service has failed with error", err)
 		return
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"os"
 
 	"github.com/gridscale/gsclient-go"
@@ -21,25 +22,22 @@ const (
 	isoImageType serviceType = "isoimage"
 )
 
-//enhancedClient inherits all methods from gsclient.Client
+//enhancedClient inherits all methods of gsclient.Operator, which is implemented by gsclient.Client
+//and by the mocks of the gsclientmock package
 //We need this to implement a new additional method
 type enhancedClient struct {
-	*gsclient.Client
+	gsclient.Operator
 }
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := enhancedClient{
 		gsclient.NewClient(config),
 	}
@@ -54,17 +52,17 @@ func main() {
 		Cores:        1,
 		LocationUUID: locationUUID,
 	}
-	cServer, err := client.CreateServer(serverCreateRequest)
+	cServer, _, err := client.CreateServer(ctx, serverCreateRequest)
 	if err != nil {
 		log.Fatal("Create server has failed with error", err)
 	}
 	log.WithFields(log.Fields{
 		"server_uuid": cServer.ObjectUUID,
 	}).Info("Server successfully created")
-	defer client.deleteService(serverType, cServer.ObjectUUID)
+	defer client.deleteService(ctx, serverType, cServer.ObjectUUID)
 
 	//get a server to interact with
-	server, err := client.GetServer(cServer.ObjectUUID)
+	server, err := client.GetServer(ctx, cServer.ObjectUUID)
 	if err != nil {
 		log.Error("Get server has failed with error", err)
 		return
@@ -73,7 +71,7 @@ func main() {
 	log.Info("Start server: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Turn on server
-	err = client.StartServer(server.Properties.ObjectUUID)
+	_, err = client.StartServer(ctx, server.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Start server has failed with error", err)
 		return
@@ -83,7 +81,7 @@ func main() {
 	log.Info("Stop server: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Turn off server
-	err = client.StopServer(server.Properties.ObjectUUID)
+	_, err = client.StopServer(ctx, server.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Stop server has failed with error", err)
 		return
@@ -93,9 +91,9 @@ func main() {
 	log.Info("Update server: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	autoRecovery := false
-	err = client.UpdateServer(server.Properties.ObjectUUID, gsclient.ServerUpdateRequest{
-		Name:         "updated server",
-		Memory:       1,
+	_, err = client.UpdateServer(ctx, server.Properties.ObjectUUID, gsclient.ServerUpdateRequest{
+		Name:         gsclient.String("updated server"),
+		Memory:       gsclient.Int(1),
 		AutoRecovery: &autoRecovery,
 	})
 	if err != nil {
@@ -105,7 +103,7 @@ func main() {
 	log.Info("Server successfully updated")
 
 	//Get events of server
-	events, err := client.GetServerEventList(server.Properties.ObjectUUID)
+	events, err := client.GetServerEventList(ctx, server.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Get events has failed with error", err)
 		return
@@ -117,7 +115,7 @@ func main() {
 	//Create storage, network, IP, and ISO-image to attach to the server
 	log.Info("Create storage, Network, IP, ISO-image: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
-	cStorage, err := client.CreateStorage(gsclient.StorageCreateRequest{
+	cStorage, _, err := client.CreateStorage(ctx, gsclient.StorageCreateRequest{
 		Capacity:     1,
 		LocationUUID: locationUUID,
 		Name:         "go-client-storage",
@@ -129,9 +127,9 @@ func main() {
 	log.WithFields(log.Fields{
 		"storage_uuid": cStorage.ObjectUUID,
 	}).Info("Storage successfully created")
-	defer client.deleteService(storageType, cStorage.ObjectUUID)
+	defer client.deleteService(ctx, storageType, cStorage.ObjectUUID)
 
-	cNetwork, err := client.CreateNetwork(gsclient.NetworkCreateRequest{
+	cNetwork, _, err := client.CreateNetwork(ctx, gsclient.NetworkCreateRequest{
 		Name:         "go-client-network",
 		LocationUUID: locationUUID,
 	})
@@ -142,9 +140,9 @@ func main() {
 	log.WithFields(log.Fields{
 		"network_uuid": cNetwork.ObjectUUID,
 	}).Info("Network successfully created")
-	defer client.deleteService(networkType, cNetwork.ObjectUUID)
+	defer client.deleteService(ctx, networkType, cNetwork.ObjectUUID)
 
-	cIP, err := client.CreateIP(gsclient.IPCreateRequest{
+	cIP, _, err := client.CreateIP(ctx, gsclient.IPCreateRequest{
 		Name:         "go-client-ip",
 		Family:       gsclient.IPv4Type,
 		LocationUUID: locationUUID,
@@ -156,9 +154,9 @@ func main() {
 	log.WithFields(log.Fields{
 		"IP_uuid": cIP.ObjectUUID,
 	}).Info("IP successfully created")
-	defer client.deleteService(ipType, cIP.ObjectUUID)
+	defer client.deleteService(ctx, ipType, cIP.ObjectUUID)
 
-	cISOimage, err := client.CreateISOImage(gsclient.ISOImageCreateRequest{
+	cISOimage, _, err := client.CreateISOImage(ctx, gsclient.ISOImageCreateRequest{
 		Name:         "go-client-iso",
 		SourceURL:    "http://tinycorelinux.net/10.x/x86/release/TinyCore-current.iso",
 		LocationUUID: locationUUID,
@@ -170,18 +168,19 @@ func main() {
 	log.WithFields(log.Fields{
 		"isoimage_uuid": cISOimage.ObjectUUID,
 	}).Info("ISO-image successfully created")
-	defer client.deleteService(isoImageType, cISOimage.ObjectUUID)
+	defer client.deleteService(ctx, isoImageType, cISOimage.ObjectUUID)
 
 	//Attach storage, network, IP, and ISO-image to a server
-	err = client.LinkStorage(server.Properties.ObjectUUID, cStorage.ObjectUUID, false)
+	_, err = client.LinkStorage(ctx, server.Properties.ObjectUUID, cStorage.ObjectUUID, false)
 	if err != nil {
 		log.Error("Link storage has failed with error", err)
 		return
 	}
 	log.Info("Storage successfully attached")
-	defer client.unlinkService(storageType, server.Properties.ObjectUUID, cStorage.ObjectUUID)
+	defer client.unlinkService(ctx, storageType, server.Properties.ObjectUUID, cStorage.ObjectUUID)
 
-	err = client.LinkNetwork(
+	_, err = client.LinkNetwork(
+		ctx,
 		server.Properties.ObjectUUID,
 		cNetwork.ObjectUUID,
 		webServerFirewallTemplateUUID,
@@ -195,38 +194,38 @@ func main() {
 		return
 	}
 	log.Info("Network successfully linked")
-	defer client.unlinkService(networkType, server.Properties.ObjectUUID, cNetwork.ObjectUUID)
+	defer client.unlinkService(ctx, networkType, server.Properties.ObjectUUID, cNetwork.ObjectUUID)
 
-	err = client.LinkIP(server.Properties.ObjectUUID, cIP.ObjectUUID)
+	_, err = client.LinkIP(ctx, server.Properties.ObjectUUID, cIP.ObjectUUID)
 	if err != nil {
 		log.Error("Link IP has failed with error", err)
 		return
 	}
 	log.Info("IP successfully linked")
-	defer client.unlinkService(ipType, server.Properties.ObjectUUID, cIP.ObjectUUID)
+	defer client.unlinkService(ctx, ipType, server.Properties.ObjectUUID, cIP.ObjectUUID)
 
-	err = client.LinkIsoImage(server.Properties.ObjectUUID, cISOimage.ObjectUUID)
+	_, err = client.LinkIsoImage(ctx, server.Properties.ObjectUUID, cISOimage.ObjectUUID)
 	if err != nil {
 		log.Error("Link ISO-image has failed with error", err)
 		return
 	}
 	log.Info("ISO-image successfully linked")
-	defer client.unlinkService(isoImageType, server.Properties.ObjectUUID, cISOimage.ObjectUUID)
+	defer client.unlinkService(ctx, isoImageType, server.Properties.ObjectUUID, cISOimage.ObjectUUID)
 
 	log.Info("Unlink and delete: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 }
 
-func (c *enhancedClient) deleteService(serviceType serviceType, id string) {
+func (c *enhancedClient) deleteService(ctx context.Context, serviceType serviceType, id string) {
 	switch serviceType {
 	case serverType:
 		//turn off server before deleting
-		err := c.StopServer(id)
+		_, err := c.StopServer(ctx, id)
 		if err != nil {
 			log.Error("Stop server has failed with error", err)
 			return
 		}
-		err = c.DeleteServer(id)
+		_, err = c.DeleteServer(ctx, id)
 		if err != nil {
 			log.Error("Delete server has failed with error", err)
 			return
@@ -235,7 +234,7 @@ func (c *enhancedClient) deleteService(serviceType serviceType, id string) {
 
 		log.Info("Get deleted servers: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		servers, err := c.GetDeletedServers()
+		servers, err := c.GetDeletedServers(ctx)
 		if err != nil {
 			log.Error("Get deleted servers has failed with error", err)
 			return
@@ -244,28 +243,28 @@ func (c *enhancedClient) deleteService(serviceType serviceType, id string) {
 			"servers": servers,
 		}).Info("Retrieved deleted servers successfully")
 	case storageType:
-		err := c.DeleteStorage(id)
+		_, err := c.DeleteStorage(ctx, id)
 		if err != nil {
 			log.Error("Delete storage has failed with error", err)
 			return
 		}
 		log.Info("Storage successfully deleted")
 	case networkType:
-		err := c.DeleteNetwork(id)
+		_, err := c.DeleteNetwork(ctx, id)
 		if err != nil {
 			log.Error("Delete network has failed with error", err)
 			return
 		}
 		log.Info("Network successfully deleted")
 	case ipType:
-		err := c.DeleteIP(id)
+		_, err := c.DeleteIP(ctx, id)
 		if err != nil {
 			log.Error("Delete IP has failed with error", err)
 			return
 		}
 		log.Info("IP successfully deleted")
 	case isoImageType:
-		err := c.DeleteISOImage(id)
+		_, err := c.DeleteISOImage(ctx, id)
 		if err != nil {
 			log.Error("Delete ISO-image has failed with error", err)
 			return
@@ -277,31 +276,31 @@ func (c *enhancedClient) deleteService(serviceType serviceType, id string) {
 	}
 }
 
-func (c *enhancedClient) unlinkService(serviceType serviceType, serverID, serviceID string) {
+func (c *enhancedClient) unlinkService(ctx context.Context, serviceType serviceType, serverID, serviceID string) {
 	switch serviceType {
 	case storageType:
-		err := c.UnlinkStorage(serverID, serviceID)
+		_, err := c.UnlinkStorage(ctx, serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink storage has failed with error", err)
 			return
 		}
 		log.Info("Storage successfully unlinked")
 	case networkType:
-		err := c.UnlinkNetwork(serverID, serviceID)
+		_, err := c.UnlinkNetwork(ctx, serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink network has failed with error", err)
 			return
 		}
 		log.Info("Network successfully unlinked")
 	case ipType:
-		err := c.UnlinkIP(serverID, serviceID)
+		_, err := c.UnlinkIP(ctx, serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink IP has failed with error", err)
 			return
 		}
 		log.Info("IP successfully unlinked")
 	case isoImageType:
-		err := c.UnlinkIsoImage(serverID, serviceID)
+		_, err := c.UnlinkIsoImage(ctx, serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink ISO-image has failed with error", err)
 			return
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	log "github.com/sirupsen/logrus"
 	"os"
 
@@ -11,25 +12,21 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
 	log.Info("Create storage and snapshot: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Create storage
-	cStorage, err := client.CreateStorage(gsclient.StorageCreateRequest{
+	cStorage, _, err := client.CreateStorage(ctx, gsclient.StorageCreateRequest{
 		Capacity:     1,
 		LocationUUID: locationUUID,
 		Name:         "go-client-storage",
@@ -42,7 +39,7 @@ func main() {
 		"storage_uuid": cStorage.ObjectUUID,
 	}).Info("Storage successfully created")
 	defer func() {
-		err := client.DeleteStorage(cStorage.ObjectUUID)
+		_, err := client.DeleteStorage(ctx, cStorage.ObjectUUID)
 		if err != nil {
 			log.Error("Delete storage has failed with error", err)
 			return
@@ -51,7 +48,7 @@ func main() {
 	}()
 
 	//Create a snapshot
-	cSnapshot, err := client.CreateStorageSnapshot(cStorage.ObjectUUID, gsclient.StorageSnapshotCreateRequest{
+	cSnapshot, _, err := client.CreateStorageSnapshot(ctx, cStorage.ObjectUUID, gsclient.StorageSnapshotCreateRequest{
 		Name: "go-client-snapshot",
 	})
 	if err != nil {
@@ -62,7 +59,7 @@ func main() {
 		"snapshot_uuid": cStorage.ObjectUUID,
 	}).Info("Snapshot successfully created")
 	defer func() {
-		err := client.DeleteStorageSnapshot(cStorage.ObjectUUID, cSnapshot.ObjectUUID)
+		_, err := client.DeleteStorageSnapshot(ctx, cStorage.ObjectUUID, cSnapshot.ObjectUUID)
 		if err != nil {
 			log.Error("Delete storage snapshot has failed with error", err)
 			return
@@ -71,7 +68,7 @@ func main() {
 
 		log.Info("Get deleted snapshots: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		snapshots, err := client.GetDeletedSnapshots()
+		snapshots, err := client.GetDeletedSnapshots(ctx)
 		if err != nil {
 			log.Error("Get deleted snapshots has failed with error", err)
 			return
@@ -82,7 +79,7 @@ func main() {
 	}()
 
 	//Get a snapshot to update
-	snapshot, err := client.GetStorageSnapshot(cStorage.ObjectUUID, cSnapshot.ObjectUUID)
+	snapshot, err := client.GetStorageSnapshot(ctx, cStorage.ObjectUUID, cSnapshot.ObjectUUID)
 	if err != nil {
 		log.Error("Get snapshot has failed with error", err)
 		return
@@ -94,8 +91,8 @@ func main() {
 	log.Info("Update snapshot: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Update a snapshot
-	err = client.UpdateStorageSnapshot(cStorage.ObjectUUID, snapshot.Properties.ObjectUUID, gsclient.StorageSnapshotUpdateRequest{
-		Name: "updated snapshot",
+	_, err = client.UpdateStorageSnapshot(ctx, cStorage.ObjectUUID, snapshot.Properties.ObjectUUID, gsclient.StorageSnapshotUpdateRequest{
+		Name: gsclient.String("updated snapshot"),
 	})
 	if err != nil {
 		log.Error("Update snapshot has failed with error", err)
@@ -106,7 +103,7 @@ func main() {
 	log.Info("Rollback storage: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Rollback
-	err = client.RollbackStorage(cStorage.ObjectUUID, snapshot.Properties.ObjectUUID, gsclient.StorageRollbackRequest{
+	_, err = client.RollbackStorage(ctx, cStorage.ObjectUUID, snapshot.Properties.ObjectUUID, gsclient.StorageRollbackRequest{
 		Rollback: true,
 	})
 	if err != nil {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	log "github.com/sirupsen/logrus"
 	"os"
 
@@ -11,25 +12,21 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
 	log.Info("Create storage and snapshot schedule: Press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Create storage
-	cStorage, err := client.CreateStorage(gsclient.StorageCreateRequest{
+	cStorage, _, err := client.CreateStorage(ctx, gsclient.StorageCreateRequest{
 		Capacity:     1,
 		LocationUUID: locationUUID,
 		Name:         "go-client-storage",
@@ -43,20 +40,20 @@ func main() {
 	}).Info("Storage successfully created")
 	defer func() {
 		//Delete all snapshots has been made so far
-		snapshots, err := client.GetStorageSnapshotList(cStorage.ObjectUUID)
+		snapshots, err := client.GetStorageSnapshotList(ctx, cStorage.ObjectUUID)
 		if err != nil {
 			log.Error("Get storage's snapshots has failed with error", err)
 			return
 		}
 		for _, snapshot := range snapshots {
-			err = client.DeleteStorageSnapshot(cStorage.ObjectUUID, snapshot.Properties.ObjectUUID)
+			_, err = client.DeleteStorageSnapshot(ctx, cStorage.ObjectUUID, snapshot.Properties.ObjectUUID)
 			if err != nil {
 				log.Error("Delete storage's snapshot has failed with error", err)
 				return
 			}
 		}
 		//we have to wait for the snapshot getting deleted firstly
-		err = client.DeleteStorage(cStorage.ObjectUUID)
+		_, err = client.DeleteStorage(ctx, cStorage.ObjectUUID)
 		if err != nil {
 			log.Error("Delete storage has failed with error", err)
 			return
@@ -65,7 +62,7 @@ func main() {
 	}()
 
 	//Create Snapshot Schedule
-	cSnapshotSchedule, err := client.CreateStorageSnapshotSchedule(cStorage.ObjectUUID, gsclient.StorageSnapshotScheduleCreateRequest{
+	cSnapshotSchedule, _, err := client.CreateStorageSnapshotSchedule(ctx, cStorage.ObjectUUID, gsclient.StorageSnapshotScheduleCreateRequest{
 		Name:          "go-client-snapshot-schedule",
 		RunInterval:   120,
 		KeepSnapshots: 2,
@@ -78,7 +75,7 @@ func main() {
 		"snapshotschedule_uuid": cSnapshotSchedule.ObjectUUID,
 	}).Info("Snapshot schedule successfully created")
 	defer func() {
-		err := client.DeleteStorageSnapshotSchedule(cStorage.ObjectUUID, cSnapshotSchedule.ObjectUUID)
+		_, err := client.DeleteStorageSnapshotSchedule(ctx, cStorage.ObjectUUID, cSnapshotSchedule.ObjectUUID)
 		if err != nil {
 			log.Error("Delete snapshot schedule has failed with error", err)
 			return
@@ -87,7 +84,7 @@ func main() {
 	}()
 
 	//Get snapshot schedule to update
-	snapshotSchedule, err := client.GetStorageSnapshotSchedule(cStorage.ObjectUUID, cSnapshotSchedule.ObjectUUID)
+	snapshotSchedule, err := client.GetStorageSnapshotSchedule(ctx, cStorage.ObjectUUID, cSnapshotSchedule.ObjectUUID)
 	if err != nil {
 		log.Error("Get snapshot schedule has failed with error", err)
 		return
@@ -98,10 +95,10 @@ func main() {
 
 	log.Info("Update snapshot schedule: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
-	err = client.UpdateStorageSnapshotSchedule(cStorage.ObjectUUID, snapshotSchedule.Properties.ObjectUUID, gsclient.StorageSnapshotScheduleUpdateRequest{
-		Name:          "updated snapshot schedule",
-		RunInterval:   snapshotSchedule.Properties.RunInterval,
-		KeepSnapshots: snapshotSchedule.Properties.KeepSnapshots,
+	_, err = client.UpdateStorageSnapshotSchedule(ctx, cStorage.ObjectUUID, snapshotSchedule.Properties.ObjectUUID, gsclient.StorageSnapshotScheduleUpdateRequest{
+		Name:          gsclient.String("updated snapshot schedule"),
+		RunInterval:   &snapshotSchedule.Properties.RunInterval,
+		KeepSnapshots: &snapshotSchedule.Properties.KeepSnapshots,
 	})
 	if err != nil {
 		log.Error("Update snapshot schedule has failed with error", err)
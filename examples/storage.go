@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"os"
 
 	log "github.com/sirupsen/logrus"
@@ -12,18 +13,14 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -31,7 +28,7 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
 	//Create a storage
-	cStorage, err := client.CreateStorage(gsclient.StorageCreateRequest{
+	cStorage, _, err := client.CreateStorage(ctx, gsclient.StorageCreateRequest{
 		Capacity:     1,
 		LocationUUID: locationUUID,
 		Name:         "go-client-storage",
@@ -45,7 +42,7 @@ func main() {
 		"storage_uuid": cStorage.ObjectUUID,
 	}).Info("Storage successfully created")
 	defer func() {
-		err := client.DeleteStorage(cStorage.ObjectUUID)
+		_, err := client.DeleteStorage(ctx, cStorage.ObjectUUID)
 		if err != nil {
 			log.Error("Delete storage has failed with error", err)
 			return
@@ -54,7 +51,7 @@ func main() {
 
 		log.Info("Get deleted storages: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		storages, err := client.GetDeletedStorages()
+		storages, err := client.GetDeletedStorages(ctx)
 		if err != nil {
 			log.Error("Get deleted storages has failed with error", err)
 			return
@@ -65,7 +62,7 @@ func main() {
 	}()
 
 	//Get storage to update
-	storage, err := client.GetStorage(cStorage.ObjectUUID)
+	storage, err := client.GetStorage(ctx, cStorage.ObjectUUID)
 	if err != nil {
 		log.Error("Get storage has failed with error", err)
 		return
@@ -77,10 +74,10 @@ func main() {
 	log.Info("Update storage: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
-	err = client.UpdateStorage(storage.Properties.ObjectUUID, gsclient.StorageUpdateRequest{
-		Name:     "updated storage",
-		Labels:   storage.Properties.Labels,
-		Capacity: storage.Properties.Capacity,
+	_, err = client.UpdateStorage(ctx, storage.Properties.ObjectUUID, gsclient.StorageUpdateRequest{
+		Name:     gsclient.String("updated storage"),
+		Labels:   &storage.Properties.Labels,
+		Capacity: &storage.Properties.Capacity,
 	})
 	if err != nil {
 		log.Error("Update storage has failed with error", err)
@@ -91,7 +88,7 @@ func main() {
 	log.Info("Get storage's events: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 
-	events, err := client.GetStorageEventList(storage.Properties.ObjectUUID)
+	events, err := client.GetStorageEventList(ctx, storage.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Get storage's events has failed with error", err)
 		return
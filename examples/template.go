@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	log "github.com/sirupsen/logrus"
 	"os"
 
@@ -11,18 +12,14 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-		true,
-		0,
-		0,
-		0,
+	ctx := context.Background()
+	config, err := gsclient.ConfigFromEnv(
+		gsclient.WithSync(true),
+		gsclient.WithLogger(gsclient.NewLogrusLogger(log.StandardLogger())),
 	)
+	if err != nil {
+		log.Fatal("Configure client has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -30,7 +27,7 @@ func main() {
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//In order to create a template, we need to create a storage and its snapshot
 	//Create storage
-	cStorage, err := client.CreateStorage(gsclient.StorageCreateRequest{
+	cStorage, _, err := client.CreateStorage(ctx, gsclient.StorageCreateRequest{
 		Capacity:     1,
 		LocationUUID: locationUUID,
 		Name:         "go-client-storage",
@@ -40,7 +37,7 @@ func main() {
 		return
 	}
 	defer func() {
-		err := client.DeleteStorage(cStorage.ObjectUUID)
+		_, err := client.DeleteStorage(ctx, cStorage.ObjectUUID)
 		if err != nil {
 			log.Error("Delete storage has failed with error", err)
 			return
@@ -49,7 +46,7 @@ func main() {
 	}()
 
 	//Create storage snapshot
-	cSnapshot, err := client.CreateStorageSnapshot(cStorage.ObjectUUID, gsclient.StorageSnapshotCreateRequest{
+	cSnapshot, _, err := client.CreateStorageSnapshot(ctx, cStorage.ObjectUUID, gsclient.StorageSnapshotCreateRequest{
 		Name: "go-client-snapshot",
 	})
 	if err != nil {
@@ -57,7 +54,7 @@ func main() {
 		return
 	}
 	defer func() {
-		err := client.DeleteStorageSnapshot(cStorage.ObjectUUID, cSnapshot.ObjectUUID)
+		_, err := client.DeleteStorageSnapshot(ctx, cStorage.ObjectUUID, cSnapshot.ObjectUUID)
 		if err != nil {
 			log.Error("Delete storage snapshot has failed with error", err)
 			return
@@ -66,7 +63,7 @@ func main() {
 	}()
 
 	//Create template
-	cTemplate, err := client.CreateTemplate(gsclient.TemplateCreateRequest{
+	cTemplate, _, err := client.CreateTemplate(ctx, gsclient.TemplateCreateRequest{
 		Name:         "go-client-template",
 		SnapshotUUID: cSnapshot.ObjectUUID,
 	})
@@ -78,7 +75,7 @@ func main() {
 		"template_uuid": cTemplate.ObjectUUID,
 	}).Info("Template successfully created")
 	defer func() {
-		err := client.DeleteTemplate(cTemplate.ObjectUUID)
+		_, err := client.DeleteTemplate(ctx, cTemplate.ObjectUUID)
 		if err != nil {
 			log.Error("Delete template has failed with error", err)
 			return
@@ -87,7 +84,7 @@ func main() {
 
 		log.Info("Get deleted templates: Press 'Enter' to continue...")
 		bufio.NewReader(os.Stdin).ReadBytes('\n')
-		templates, err := client.GetDeletedTemplates()
+		templates, err := client.GetDeletedTemplates(ctx)
 		if err != nil {
 			log.Error("Get deleted templates has failed with error", err)
 			return
@@ -98,7 +95,7 @@ func main() {
 	}()
 
 	//get a template to update
-	template, err := client.GetTemplate(cTemplate.ObjectUUID)
+	template, err := client.GetTemplate(ctx, cTemplate.ObjectUUID)
 	if err != nil {
 		log.Error("Get template has failed with error", err)
 		return
@@ -110,9 +107,9 @@ func main() {
 	log.Info("Update template: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Update template
-	err = client.UpdateTemplate(template.Properties.ObjectUUID, gsclient.TemplateUpdateRequest{
-		Name:   "updated template",
-		Labels: template.Properties.Labels,
+	_, err = client.UpdateTemplate(ctx, template.Properties.ObjectUUID, gsclient.TemplateUpdateRequest{
+		Name:   gsclient.String("updated template"),
+		Labels: &template.Properties.Labels,
 	})
 	if err != nil {
 		log.Error("Update template has failed with error", err)
@@ -123,7 +120,7 @@ func main() {
 	log.Info("Get template's events: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 	//Get template's events
-	events, err := client.GetTemplateEventList(template.Properties.ObjectUUID)
+	events, err := client.GetTemplateEventList(ctx, template.Properties.ObjectUUID)
 	if err != nil {
 		log.Error("Get template's events has failed with error", err)
 		return
@@ -1,11 +1,24 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//FirewallOperator is an interface defining API of a firewall operator
+type FirewallOperator interface {
+	GetFirewallList(ctx context.Context, opts ...ListOptions) ([]Firewall, error)
+	FirewallsIter(ctx context.Context, yield func(Firewall) bool) error
+	GetFirewall(ctx context.Context, id string) (Firewall, error)
+	CreateFirewall(ctx context.Context, body FirewallCreateRequest) (FirewallCreateResponse, *Operation, error)
+	UpdateFirewall(ctx context.Context, id string, body FirewallUpdateRequest) (*Operation, error)
+	UpdateFirewallFunc(ctx context.Context, id string, mutate func(*FirewallProperties) error) (*Operation, error)
+	DeleteFirewall(ctx context.Context, id string) (*Operation, error)
+	GetFirewallEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+}
+
 //FirewallList is JSON structure of a list of firewalls
 type FirewallList struct {
 	//Array of firewalls
@@ -136,6 +149,15 @@ type FirewallCreateRequest struct {
 	Rules FirewallRules `json:"rules"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r FirewallCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.labels("labels", r.Labels)
+	v.firewallRules("rules", r.Rules)
+	return v.err()
+}
+
 //FirewallCreateResponse is JSON struct of a response for creating a firewall
 type FirewallCreateResponse struct {
 	//Request UUID
@@ -148,148 +170,212 @@ type FirewallCreateResponse struct {
 //FirewallUpdateRequest is JSON struct of a request for updating a firewall
 type FirewallUpdateRequest struct {
 	//New name. Leave it if you do not want to update the name
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//New list of labels. Leave it if you do not want to update the Labels
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
 
 	//FirewallRules. Leave it if you do not want to update the firewall rules
 	Rules *FirewallRules `json:"rules,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r FirewallUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	if r.Rules != nil {
+		v.firewallRules("rules", *r.Rules)
+	}
+	return v.err()
+}
+
 //GetFirewallList gets a list of available firewalls
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getFirewalls
-func (c *Client) GetFirewallList() ([]Firewall, error) {
+func (c *Client) GetFirewallList(ctx context.Context, opts ...ListOptions) ([]Firewall, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiFirewallBase),
+		uri:    path.Join(apiFirewallBase) + options.query(),
 		method: http.MethodGet,
 	}
 	var response FirewallList
 	var firewalls []Firewall
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		firewalls = append(firewalls, Firewall{Properties: properties})
 	}
-	return firewalls, err
+	return firewalls, options.apply(&firewalls)
+}
+
+//FirewallsIter calls yield for all firewalls, in the order of the response. The response is decoded one object at a
+//time instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) FirewallsIter(ctx context.Context, yield func(Firewall) bool) error {
+	return c.iterate(ctx, apiFirewallBase, "firewalls", func(key string, dec *json.Decoder) (bool, error) {
+		var firewall Firewall
+		if err := dec.Decode(&firewall.Properties); err != nil {
+			return false, err
+		}
+		if firewall.Properties.ObjectUUID == "" {
+			firewall.Properties.ObjectUUID = key
+		}
+		return yield(firewall), nil
+	})
 }
 
 //GetFirewall gets a specific firewall based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getFirewall
-func (c *Client) GetFirewall(id string) (Firewall, error) {
+func (c *Client) GetFirewall(ctx context.Context, id string) (Firewall, error) {
 	if !isValidUUID(id) {
-		return Firewall{}, errors.New("'id' is invalid")
+		return Firewall{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiFirewallBase, id),
 		method: http.MethodGet,
 	}
 	var response Firewall
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //CreateFirewall creates a new firewall
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createFirewall
-func (c *Client) CreateFirewall(body FirewallCreateRequest) (FirewallCreateResponse, error) {
+func (c *Client) CreateFirewall(ctx context.Context, body FirewallCreateRequest) (FirewallCreateResponse, *Operation, error) {
 	r := Request{
 		uri:    path.Join(apiFirewallBase),
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response FirewallCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return FirewallCreateResponse{}, err
+		return FirewallCreateResponse{}, nil, err
 	}
-	//Block until the request is finished
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "firewall", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //UpdateFirewall update a specific firewall
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateFirewall
-func (c *Client) UpdateFirewall(id string, body FirewallUpdateRequest) error {
+func (c *Client) UpdateFirewall(ctx context.Context, id string, body FirewallUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiFirewallBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForFirewallActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "firewall", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForFirewallActive(ctx, id)
+	})
+}
+
+//UpdateFirewallFunc updates a firewall by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateFirewallFunc(ctx context.Context, id string, mutate func(*FirewallProperties) error) (*Operation, error) {
+	var body FirewallUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "firewall",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetFirewall(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*FirewallProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateFirewall(ctx, id, body)
+		},
+	})
 }
 
 //DeleteFirewall delete a specific firewall
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteFirewall
-func (c *Client) DeleteFirewall(id string) error {
+func (c *Client) DeleteFirewall(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiFirewallBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForFirewallDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "firewall", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForFirewallDeleted(ctx, id)
+	})
 }
 
 //GetFirewallEventList get list of a firewall's events
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getFirewallEvents
-func (c *Client) GetFirewallEventList(id string) ([]Event, error) {
+func (c *Client) GetFirewallEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiFirewallBase, id, "events"),
+		uri:    path.Join(apiFirewallBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var firewallEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		firewallEvents = append(firewallEvents, Event{Properties: properties})
 	}
-	return firewallEvents, err
+	return firewallEvents, options.apply(&firewallEvents)
 }
 
 //waitForFirewallActive allows to wait until the firewall's status is active
-func (c *Client) waitForFirewallActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		fw, err := c.GetFirewall(id)
-		return fw.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForFirewallActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetFirewall(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForFirewallDeleted allows to wait until the firewall is deleted
-func (c *Client) waitForFirewallDeleted(id string) error {
+func (c *Client) waitForFirewallDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiFirewallBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -0,0 +1,70 @@
+//Package gsclientmock provides mocks of the operator interfaces of gsclient, e.g. for unit tests of code
+//which depends on gsclient.ServerOperator instead of *gsclient.Client.
+//
+//Each mock records its calls and returns the result of the matching XxxFunc field, or zero values if the
+//field is not set:
+//
+//	mock := &gsclientmock.ServerOperator{
+//		GetServerFunc: func(ctx context.Context, id string) (gsclient.Server, error) {
+//			return gsclient.Server{Properties: gsclient.ServerProperties{ObjectUUID: id}}, nil
+//		},
+//	}
+//	//... run the code under test
+//	calls := mock.CallsTo("GetServer")
+//
+//Methods which have a XxxFunc counterpart in the interface (e.g. UpdateServer and UpdateServerFunc) use a XxxFn
+//field instead, so that UpdateServerFn is called by UpdateServer and UpdateServerFuncFunc by UpdateServerFunc.
+//
+//The mocks are generated from the interfaces by running go generate in the root of the repository.
+package gsclientmock
+
+import "sync"
+
+//Call is a recorded call of a mock
+type Call struct {
+	//Name of the called method
+	Method string
+
+	//Arguments of the call, including the context
+	Args []interface{}
+}
+
+//Recorder records the calls of a mock, it is embedded in all mocks
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+//record adds a call
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+//Calls returns all recorded calls in the order they have been made
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+//CallsTo returns the recorded calls of a method in the order they have been made
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+//Reset removes all recorded calls
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
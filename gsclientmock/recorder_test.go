@@ -0,0 +1,63 @@
+package gsclientmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gridscale/gsclient-go"
+	"github.com/stretchr/testify/assert"
+)
+
+//startAll starts all given servers, it depends on the interface only
+func startAll(ctx context.Context, op gsclient.ServerOperator, ids ...string) error {
+	for _, id := range ids {
+		if _, err := op.StartServer(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestServerOperator(t *testing.T) {
+	ctx := context.Background()
+	mock := &ServerOperator{
+		StartServerFunc: func(ctx context.Context, id string) (*gsclient.Operation, error) {
+			if id == "broken" {
+				return nil, gsclient.ErrConflict
+			}
+			return nil, nil
+		},
+	}
+	assert.Nil(t, startAll(ctx, mock, "a", "b"))
+	err := startAll(ctx, mock, "broken", "c")
+	assert.True(t, errors.Is(err, gsclient.ErrConflict))
+
+	calls := mock.CallsTo("StartServer")
+	if assert.Len(t, calls, 3) {
+		assert.Equal(t, []interface{}{ctx, "a"}, calls[0].Args)
+		assert.Equal(t, "broken", calls[2].Args[1])
+	}
+	assert.Empty(t, mock.CallsTo("StopServer"))
+
+	//methods without a function return zero values
+	server, err := mock.GetServer(ctx, "a")
+	assert.Nil(t, err)
+	assert.Equal(t, gsclient.Server{}, server)
+	assert.Len(t, mock.Calls(), 4)
+
+	mock.Reset()
+	assert.Empty(t, mock.Calls())
+}
+
+func TestOperator(t *testing.T) {
+	var op gsclient.Operator = &Operator{
+		GetIPVersionFunc: func(ctx context.Context, id string) int {
+			return 6
+		},
+	}
+	assert.Equal(t, 6, op.GetIPVersion(context.Background(), "ip"))
+	_, err := op.LinkStorage(context.Background(), "server", "storage", true)
+	assert.Nil(t, err)
+	assert.Len(t, op.(*Operator).Calls(), 2)
+}
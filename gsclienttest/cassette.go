@@ -0,0 +1,298 @@
+package gsclienttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+//Redacted replaces secrets in recorded cassettes
+const Redacted = "REDACTED"
+
+//RedactedUserUUID replaces the user UUID in recorded cassettes
+const RedactedUserUUID = "00000000-0000-0000-0000-000000000000"
+
+//Cassette is a list of recorded requests and their responses
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+//Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+//RecordedRequest is a recorded request
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URI    string      `json:"uri"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+//RecordedResponse is a recorded response
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+//LoadCassette reads a cassette file
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+//Save writes the cassette to a file
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+//Recorder is an http.RoundTripper which records all requests and responses passing through it.
+//Use it with gsclient.WithHTTPClient(&http.Client{Transport: recorder}) and call Save when done.
+//
+//The X-Auth-Token header is replaced by Redacted, and the user UUID (taken from the X-Auth-UserID header)
+//is replaced by RedactedUserUUID wherever it occurs.
+type Recorder struct {
+	//Transport sends the requests, http.DefaultTransport if nil
+	Transport http.RoundTripper
+
+	path     string
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+//NewRecorder creates a recorder which writes its cassette to the given path
+func NewRecorder(path string, transport http.RoundTripper) *Recorder {
+	return &Recorder{
+		Transport: transport,
+		path:      path,
+	}
+}
+
+//RoundTrip sends the request and records it together with its response
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	redact := newRedactor(req.Header.Get("X-Auth-UserID"))
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URI:    redact(req.URL.RequestURI()),
+			Header: redactHeader(req.Header, redact),
+			Body:   redact(string(reqBody)),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header, redact),
+			Body:       redact(string(respBody)),
+		},
+	}
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+//Cassette returns a copy of everything recorded so far
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+//Save writes the recorded cassette to the path of the recorder
+func (r *Recorder) Save() error {
+	return r.Cassette().Save(r.path)
+}
+
+//ReplayMode defines how a Replayer finds the response of a request
+type ReplayMode int
+
+//All available replay modes
+const (
+	//ReplayInOrder replays the interactions in the order they have been recorded.
+	//Method and URI of each request have to match the recorded ones.
+	ReplayInOrder ReplayMode = iota
+
+	//ReplayByMatch replays the first unused interaction with the same method, URI and body.
+	//JSON bodies are compared by their content, not by their formatting.
+	ReplayByMatch
+)
+
+//Replayer is an http.RoundTripper which answers requests from a cassette without any network access.
+//It returns an error for requests which are not in the cassette.
+type Replayer struct {
+	cassette *Cassette
+	mode     ReplayMode
+	mu       sync.Mutex
+	next     int
+	used     []bool
+}
+
+//NewReplayer creates a replayer for a cassette file
+func NewReplayer(path string, mode ReplayMode) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewCassetteReplayer(cassette, mode), nil
+}
+
+//NewCassetteReplayer creates a replayer for a cassette in memory
+func NewCassetteReplayer(cassette *Cassette, mode ReplayMode) *Replayer {
+	return &Replayer{
+		cassette: cassette,
+		mode:     mode,
+		used:     make([]bool, len(cassette.Interactions)),
+	}
+}
+
+//RoundTrip answers the request with the recorded response
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	redact := newRedactor(req.Header.Get("X-Auth-UserID"))
+	uri := redact(req.URL.RequestURI())
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	index := -1
+	switch r.mode {
+	case ReplayInOrder:
+		if r.next < len(r.cassette.Interactions) {
+			recorded := r.cassette.Interactions[r.next].Request
+			if recorded.Method != req.Method || recorded.URI != uri {
+				return nil, fmt.Errorf("gsclienttest: request %d is %s %s, but %s %s has been recorded", r.next,
+					req.Method, uri, recorded.Method, recorded.URI)
+			}
+			index = r.next
+			r.next++
+		}
+	case ReplayByMatch:
+		for i, interaction := range r.cassette.Interactions {
+			recorded := interaction.Request
+			if !r.used[i] && recorded.Method == req.Method && recorded.URI == uri &&
+				sameBody(recorded.Body, redact(string(body))) {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("gsclienttest: no recorded response left for %s %s", req.Method, uri)
+	}
+	r.used[index] = true
+	recorded := r.cassette.Interactions[index].Response
+	header := recorded.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header.Clone(),
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+//Done reports whether all recorded interactions have been replayed
+func (r *Replayer) Done() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, used := range r.used {
+		if !used {
+			return false
+		}
+	}
+	return true
+}
+
+//readBody reads a body and replaces it with an unread copy
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+//newRedactor returns a function replacing the user UUID in a string
+func newRedactor(userUUID string) func(string) string {
+	return func(s string) string {
+		if userUUID == "" {
+			return s
+		}
+		return strings.Replace(s, userUUID, RedactedUserUUID, -1)
+	}
+}
+
+//redactHeader copies a header and removes secrets from it
+func redactHeader(header http.Header, redact func(string) string) http.Header {
+	redacted := make(http.Header, len(header))
+	for key, values := range header {
+		for _, value := range values {
+			if http.CanonicalHeaderKey(key) == "X-Auth-Token" {
+				value = Redacted
+			}
+			redacted.Add(key, redact(value))
+		}
+	}
+	return redacted
+}
+
+//sameBody compares two bodies, by their content if both are JSON
+func sameBody(a, b string) bool {
+	if strings.TrimSpace(a) == strings.TrimSpace(b) {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return bytes.Equal(ja, jb)
+}
@@ -0,0 +1,99 @@
+package gsclienttest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gridscale/gsclient-go"
+	"github.com/stretchr/testify/assert"
+)
+
+//provision runs a typical provisioning flow
+func provision(client *gsclient.Client) error {
+	server, _, err := client.CreateServer(emptyCtx, gsclient.ServerCreateRequest{Name: "server", Cores: 1, Memory: 2})
+	if err != nil {
+		return err
+	}
+	storage, _, err := client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "storage", Capacity: 10})
+	if err != nil {
+		return err
+	}
+	_, err = client.LinkStorage(emptyCtx, server.ObjectUUID, storage.ObjectUUID, true)
+	if err != nil {
+		return err
+	}
+	_, err = client.StartServer(emptyCtx, server.ObjectUUID)
+	return err
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gsclienttest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	fake := NewServer()
+	recorder := NewRecorder(path, nil)
+	err = provision(fake.Client(gsclient.WithSync(true), gsclient.WithHTTPClient(&http.Client{Transport: recorder})))
+	fake.Close()
+	assert.Nil(t, err)
+	assert.Nil(t, recorder.Save())
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(data), APIToken))
+	assert.False(t, strings.Contains(string(data), UserUUID))
+	assert.True(t, strings.Contains(string(data), Redacted))
+
+	//the fake API has been closed, so all responses have to come from the cassette
+	replayer, err := NewReplayer(path, ReplayInOrder)
+	assert.Nil(t, err)
+	client := fake.Client(gsclient.WithSync(true), gsclient.WithHTTPClient(&http.Client{Transport: replayer}))
+	assert.Nil(t, provision(client))
+	assert.True(t, replayer.Done())
+
+	//replaying more requests than recorded fails
+	_, err = client.GetServerList(emptyCtx)
+	assert.NotNil(t, err)
+}
+
+func TestReplayer_InOrderMismatch(t *testing.T) {
+	cassette := &Cassette{Interactions: []Interaction{
+		{
+			Request:  RecordedRequest{Method: http.MethodGet, URI: "/objects/servers"},
+			Response: RecordedResponse{StatusCode: http.StatusOK, Body: `{"servers": {}}`},
+		},
+	}}
+	client := gsclient.NewClient(gsclient.NewConfig(gsclient.WithHTTPClient(&http.Client{
+		Transport: NewCassetteReplayer(cassette, ReplayInOrder),
+	})))
+	_, err := client.GetStorageList(emptyCtx)
+	assert.NotNil(t, err)
+}
+
+func TestReplayer_ByMatch(t *testing.T) {
+	fake := NewServer()
+	recorder := NewRecorder("", nil)
+	client := fake.Client(gsclient.WithHTTPClient(&http.Client{Transport: recorder}))
+	first, _, err := client.CreateNetwork(emptyCtx, gsclient.NetworkCreateRequest{Name: "first"})
+	assert.Nil(t, err)
+	second, _, err := client.CreateNetwork(emptyCtx, gsclient.NetworkCreateRequest{Name: "second"})
+	assert.Nil(t, err)
+	fake.Close()
+
+	replayer := NewCassetteReplayer(recorder.Cassette(), ReplayByMatch)
+	client = fake.Client(gsclient.WithHTTPClient(&http.Client{Transport: replayer}))
+	res, _, err := client.CreateNetwork(emptyCtx, gsclient.NetworkCreateRequest{Name: "second"})
+	assert.Nil(t, err)
+	assert.Equal(t, second.ObjectUUID, res.ObjectUUID)
+	res, _, err = client.CreateNetwork(emptyCtx, gsclient.NetworkCreateRequest{Name: "first"})
+	assert.Nil(t, err)
+	assert.Equal(t, first.ObjectUUID, res.ObjectUUID)
+	_, _, err = client.CreateNetwork(emptyCtx, gsclient.NetworkCreateRequest{Name: "first"})
+	assert.NotNil(t, err)
+	assert.True(t, replayer.Done())
+}
@@ -0,0 +1,559 @@
+package gsclienttest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gridscale/gsclient-go"
+)
+
+//serverCreateRequest is the part of a server creation the fake API understands
+type serverCreateRequest struct {
+	Name             string   `json:"name"`
+	Memory           int      `json:"memory"`
+	Cores            int      `json:"cores"`
+	LocationUUID     string   `json:"location_uuid"`
+	HardwareProfile  string   `json:"hardware_profile"`
+	AvailabilityZone string   `json:"availability_zone"`
+	Labels           []string `json:"labels"`
+	AutoRecovery     *bool    `json:"auto_recovery"`
+	Relations        *struct {
+		Storages []struct {
+			StorageUUID string `json:"storage_uuid"`
+			BootDevice  bool   `json:"bootdevice"`
+		} `json:"storages"`
+		Networks []struct {
+			NetworkUUID string `json:"network_uuid"`
+			BootDevice  bool   `json:"bootdevice"`
+		} `json:"networks"`
+		PublicIPs []struct {
+			IPaddrUUID string `json:"ipaddr_uuid"`
+		} `json:"public_ips"`
+	} `json:"relations"`
+}
+
+//serveServers handles /objects/servers
+func (s *Server) serveServers(c *call) {
+	switch {
+	case c.is(http.MethodGet, 0):
+		list := make(map[string]gsclient.ServerProperties)
+		for id, server := range s.servers {
+			s.observe(id, &server.Status)
+			list[id] = *server
+		}
+		writeJSON(c.w, http.StatusOK, gsclient.ServerList{List: list})
+		return
+	case c.is(http.MethodPost, 0):
+		s.createServer(c)
+		return
+	case len(c.path) == 0:
+		notImplemented(c.w, c.r)
+		return
+	}
+	id := c.path[0]
+	server, ok := s.servers[id]
+	if !ok {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 1):
+		s.observe(id, &server.Status)
+		writeJSON(c.w, http.StatusOK, gsclient.Server{Properties: *server})
+	case c.is(http.MethodPatch, 1):
+		err := applyPatch(server, c.body, "name", "memory", "cores", "labels", "availability_zone", "auto_recovery",
+			"hardware_profile")
+		if err != nil {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+			return
+		}
+		server.ChangeTime = now()
+		s.accepted(c.w)
+	case c.is(http.MethodDelete, 1):
+		for len(server.Relations.Storages) > 0 {
+			s.unlinkStorage(server, server.Relations.Storages[0].ObjectUUID)
+		}
+		for len(server.Relations.Networks) > 0 {
+			s.unlinkNetwork(server, server.Relations.Networks[0].ObjectUUID)
+		}
+		for len(server.Relations.PublicIPs) > 0 {
+			s.unlinkIP(server, server.Relations.PublicIPs[0].ObjectUUID)
+		}
+		delete(s.servers, id)
+		s.accepted(c.w)
+	case c.is(http.MethodPatch, 2) && c.path[1] == "power":
+		var body gsclient.ServerPowerUpdateRequest
+		if !c.decode(&body) {
+			return
+		}
+		server.Power = body.Power
+		server.ChangeTime = now()
+		s.accepted(c.w)
+	case c.is(http.MethodPatch, 2) && c.path[1] == "shutdown":
+		server.Power = false
+		server.ChangeTime = now()
+		s.accepted(c.w)
+	case len(c.path) >= 2 && c.path[1] == "storages":
+		s.serveServerStorages(c, server)
+	case len(c.path) >= 2 && c.path[1] == "networks":
+		s.serveServerNetworks(c, server)
+	case len(c.path) >= 2 && c.path[1] == "ips":
+		s.serveServerIPs(c, server)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//createServer creates a server and the relations given in the request
+func (s *Server) createServer(c *call) {
+	var body serverCreateRequest
+	if !c.decode(&body) {
+		return
+	}
+	id := uuid.New().String()
+	server := &gsclient.ServerProperties{
+		ObjectUUID:       id,
+		Name:             body.Name,
+		Memory:           body.Memory,
+		Cores:            body.Cores,
+		HardwareProfile:  body.HardwareProfile,
+		AvailabilityZone: body.AvailabilityZone,
+		LocationUUID:     locationOrDefault(body.LocationUUID),
+		Labels:           body.Labels,
+		AutoRecovery:     body.AutoRecovery == nil || *body.AutoRecovery,
+		Status:           s.provision(id),
+		CreateTime:       now(),
+		ChangeTime:       now(),
+	}
+	if server.HardwareProfile == "" {
+		server.HardwareProfile = "default"
+	}
+	response := map[string]interface{}{
+		"server_uuid":   id,
+		"storage_uuids": []string{},
+		"network_uuids": []string{},
+		"ipaddr_uuids":  []string{},
+	}
+	if body.Relations != nil {
+		//check all relations before creating any of them
+		for _, rel := range body.Relations.Storages {
+			if _, ok := s.storages[rel.StorageUUID]; !ok {
+				notFound(c.w)
+				return
+			}
+		}
+		for _, rel := range body.Relations.Networks {
+			if _, ok := s.networks[rel.NetworkUUID]; !ok {
+				notFound(c.w)
+				return
+			}
+		}
+		for _, rel := range body.Relations.PublicIPs {
+			if _, ok := s.ips[rel.IPaddrUUID]; !ok {
+				notFound(c.w)
+				return
+			}
+		}
+		var storageUUIDs, networkUUIDs, ipUUIDs []string
+		for _, rel := range body.Relations.Storages {
+			s.linkStorage(server, s.storages[rel.StorageUUID], rel.BootDevice)
+			storageUUIDs = append(storageUUIDs, rel.StorageUUID)
+		}
+		for _, rel := range body.Relations.Networks {
+			s.linkNetwork(server, s.networks[rel.NetworkUUID], gsclient.ServerNetworkRelationCreateRequest{
+				ObjectUUID: rel.NetworkUUID,
+				BootDevice: rel.BootDevice,
+			})
+			networkUUIDs = append(networkUUIDs, rel.NetworkUUID)
+		}
+		for _, rel := range body.Relations.PublicIPs {
+			s.linkIP(server, s.ips[rel.IPaddrUUID])
+			ipUUIDs = append(ipUUIDs, rel.IPaddrUUID)
+		}
+		response["storage_uuids"] = storageUUIDs
+		response["network_uuids"] = networkUUIDs
+		response["ipaddr_uuids"] = ipUUIDs
+	}
+	s.servers[id] = server
+	s.created(c.w, id, response)
+}
+
+//storageCreateRequest is the part of a storage creation the fake API understands
+type storageCreateRequest struct {
+	Name         string   `json:"name"`
+	Capacity     int      `json:"capacity"`
+	LocationUUID string   `json:"location_uuid"`
+	StorageType  string   `json:"storage_type"`
+	Labels       []string `json:"labels"`
+	Template     *struct {
+		TemplateUUID string `json:"template_uuid"`
+	} `json:"template"`
+}
+
+//serveStorages handles /objects/storages
+func (s *Server) serveStorages(c *call) {
+	switch {
+	case c.is(http.MethodGet, 0):
+		list := make(map[string]gsclient.StorageProperties)
+		for id, storage := range s.storages {
+			s.observe(id, &storage.Status)
+			list[id] = *storage
+		}
+		writeJSON(c.w, http.StatusOK, gsclient.StorageList{List: list})
+		return
+	case c.is(http.MethodPost, 0):
+		var body storageCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		if body.Capacity <= 0 {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", "capacity has to be greater than 0")
+			return
+		}
+		id := uuid.New().String()
+		storage := &gsclient.StorageProperties{
+			ObjectUUID:   id,
+			Name:         body.Name,
+			Capacity:     body.Capacity,
+			LocationUUID: locationOrDefault(body.LocationUUID),
+			StorageType:  body.StorageType,
+			Labels:       body.Labels,
+			Status:       s.provision(id),
+			CreateTime:   now(),
+			ChangeTime:   now(),
+		}
+		if storage.StorageType == "" {
+			storage.StorageType = "storage"
+		}
+		if body.Template != nil {
+			storage.LastUsedTemplate = body.Template.TemplateUUID
+		}
+		s.storages[id] = storage
+		s.created(c.w, id, nil)
+		return
+	case len(c.path) == 0:
+		notImplemented(c.w, c.r)
+		return
+	}
+	id := c.path[0]
+	storage, ok := s.storages[id]
+	if !ok {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 1):
+		s.observe(id, &storage.Status)
+		writeJSON(c.w, http.StatusOK, gsclient.Storage{Properties: *storage})
+	case c.is(http.MethodPatch, 1):
+		capacity := storage.Capacity
+		if err := applyPatch(storage, c.body, "name", "labels", "capacity"); err != nil {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+			return
+		}
+		if storage.Capacity < capacity {
+			storage.Capacity = capacity
+			writeError(c.w, http.StatusBadRequest, "Bad Request", "the capacity of a storage cannot be reduced")
+			return
+		}
+		storage.ChangeTime = now()
+		s.accepted(c.w)
+	case c.is(http.MethodDelete, 1):
+		if len(storage.Relations.Servers) > 0 {
+			conflict(c.w, fmt.Sprintf("storage is still linked to server %s", storage.Relations.Servers[0].ObjectUUID))
+			return
+		}
+		for _, snapshot := range storage.Snapshots {
+			delete(s.snapshots, snapshot.ObjectUUID)
+		}
+		delete(s.storages, id)
+		s.accepted(c.w)
+	case len(c.path) >= 2 && c.path[1] == "snapshots":
+		s.serveSnapshots(c, storage)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//serveSnapshots handles /objects/storages/{id}/snapshots
+func (s *Server) serveSnapshots(c *call, storage *gsclient.StorageProperties) {
+	switch {
+	case c.is(http.MethodGet, 2):
+		list := make(map[string]gsclient.StorageSnapshotProperties)
+		for id, snapshot := range s.snapshots {
+			if snapshot.ParentUUID == storage.ObjectUUID {
+				s.observe(id, &snapshot.Status)
+				list[id] = *snapshot
+			}
+		}
+		writeJSON(c.w, http.StatusOK, gsclient.StorageSnapshotList{List: list})
+		return
+	case c.is(http.MethodPost, 2):
+		var body gsclient.StorageSnapshotCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		id := uuid.New().String()
+		snapshot := &gsclient.StorageSnapshotProperties{
+			ObjectUUID:   id,
+			Name:         body.Name,
+			Labels:       body.Labels,
+			ParentUUID:   storage.ObjectUUID,
+			Capacity:     storage.Capacity,
+			LocationUUID: storage.LocationUUID,
+			Status:       s.provision(id),
+			CreateTime:   now(),
+			ChangeTime:   now(),
+		}
+		s.snapshots[id] = snapshot
+		storage.Snapshots = append(storage.Snapshots, gsclient.StorageSnapshotRelation{
+			ObjectUUID:       id,
+			ObjectName:       snapshot.Name,
+			StorageUUID:      storage.ObjectUUID,
+			ObjectCapacity:   snapshot.Capacity,
+			LastUsedTemplate: storage.LastUsedTemplate,
+			CreateTime:       snapshot.CreateTime,
+		})
+		s.created(c.w, id, nil)
+		return
+	case len(c.path) < 3:
+		notImplemented(c.w, c.r)
+		return
+	}
+	id := c.path[2]
+	snapshot, ok := s.snapshots[id]
+	if !ok || snapshot.ParentUUID != storage.ObjectUUID {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 3):
+		s.observe(id, &snapshot.Status)
+		writeJSON(c.w, http.StatusOK, gsclient.StorageSnapshot{Properties: *snapshot})
+	case c.is(http.MethodPatch, 3):
+		if err := applyPatch(snapshot, c.body, "name", "labels"); err != nil {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+			return
+		}
+		snapshot.ChangeTime = now()
+		s.accepted(c.w)
+	case c.is(http.MethodDelete, 3):
+		delete(s.snapshots, id)
+		for i, rel := range storage.Snapshots {
+			if rel.ObjectUUID == id {
+				storage.Snapshots = append(storage.Snapshots[:i], storage.Snapshots[i+1:]...)
+				break
+			}
+		}
+		s.accepted(c.w)
+	case c.is(http.MethodPatch, 4) && c.path[3] == "rollback":
+		storage.ChangeTime = now()
+		s.accepted(c.w)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//networkCreateRequest is the part of a network creation the fake API understands
+type networkCreateRequest struct {
+	Name         string   `json:"name"`
+	LocationUUID string   `json:"location_uuid"`
+	L2Security   bool     `json:"l2security"`
+	Labels       []string `json:"labels"`
+}
+
+//serveNetworks handles /objects/networks
+func (s *Server) serveNetworks(c *call) {
+	switch {
+	case c.is(http.MethodGet, 0):
+		list := make(map[string]gsclient.NetworkProperties)
+		for id, network := range s.networks {
+			s.observe(id, &network.Status)
+			list[id] = *network
+		}
+		writeJSON(c.w, http.StatusOK, gsclient.NetworkList{List: list})
+		return
+	case c.is(http.MethodPost, 0):
+		var body networkCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		id := uuid.New().String()
+		s.networks[id] = &gsclient.NetworkProperties{
+			ObjectUUID:   id,
+			Name:         body.Name,
+			LocationUUID: locationOrDefault(body.LocationUUID),
+			L2Security:   body.L2Security,
+			Labels:       body.Labels,
+			NetworkType:  "network",
+			Status:       s.provision(id),
+			CreateTime:   now(),
+			ChangeTime:   now(),
+		}
+		s.created(c.w, id, nil)
+		return
+	case len(c.path) == 0:
+		notImplemented(c.w, c.r)
+		return
+	}
+	id := c.path[0]
+	network, ok := s.networks[id]
+	if !ok {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 1):
+		s.observe(id, &network.Status)
+		writeJSON(c.w, http.StatusOK, gsclient.Network{Properties: *network})
+	case c.is(http.MethodPatch, 1):
+		if err := applyPatch(network, c.body, "name", "labels", "l2security"); err != nil {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+			return
+		}
+		network.ChangeTime = now()
+		s.accepted(c.w)
+	case c.is(http.MethodDelete, 1):
+		if len(network.Relations.Servers) > 0 {
+			conflict(c.w, fmt.Sprintf("network is still linked to server %s", network.Relations.Servers[0].ObjectUUID))
+			return
+		}
+		delete(s.networks, id)
+		s.accepted(c.w)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//ipCreateRequest is the part of an IP address creation the fake API understands
+type ipCreateRequest struct {
+	Name         string   `json:"name"`
+	Family       int      `json:"family"`
+	LocationUUID string   `json:"location_uuid"`
+	Failover     bool     `json:"failover"`
+	ReverseDNS   string   `json:"reverse_dns"`
+	Labels       []string `json:"labels"`
+}
+
+//serveIPs handles /objects/ips
+func (s *Server) serveIPs(c *call) {
+	switch {
+	case c.is(http.MethodGet, 0):
+		list := make(map[string]gsclient.IPProperties)
+		for id, ip := range s.ips {
+			s.observe(id, &ip.Status)
+			list[id] = *ip
+		}
+		writeJSON(c.w, http.StatusOK, gsclient.IPList{List: list})
+		return
+	case c.is(http.MethodPost, 0):
+		var body ipCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		s.ipCounter++
+		id := uuid.New().String()
+		ip := &gsclient.IPProperties{
+			ObjectUUID:   id,
+			Name:         body.Name,
+			Family:       body.Family,
+			LocationUUID: locationOrDefault(body.LocationUUID),
+			Failover:     body.Failover,
+			ReverseDNS:   body.ReverseDNS,
+			Labels:       body.Labels,
+			Status:       s.provision(id),
+			CreateTime:   now(),
+			ChangeTime:   now(),
+		}
+		switch body.Family {
+		case 4:
+			ip.IP = fmt.Sprintf("185.201.%d.%d", s.ipCounter/254, s.ipCounter%254+1)
+			ip.Prefix = ip.IP + "/32"
+		case 6:
+			ip.IP = fmt.Sprintf("2a06:2380:0:1::%x", s.ipCounter)
+			ip.Prefix = ip.IP + "/128"
+		default:
+			writeError(c.w, http.StatusBadRequest, "Bad Request", "family has to be 4 or 6")
+			return
+		}
+		s.ips[id] = ip
+		s.created(c.w, id, map[string]interface{}{
+			"ip":     ip.IP,
+			"prefix": ip.Prefix,
+		})
+		return
+	case len(c.path) == 0:
+		notImplemented(c.w, c.r)
+		return
+	}
+	id := c.path[0]
+	ip, ok := s.ips[id]
+	if !ok {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 1):
+		s.observe(id, &ip.Status)
+		writeJSON(c.w, http.StatusOK, gsclient.IP{Properties: *ip})
+	case c.is(http.MethodPatch, 1):
+		if err := applyPatch(ip, c.body, "name", "labels", "failover", "reverse_dns"); err != nil {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+			return
+		}
+		ip.ChangeTime = now()
+		s.accepted(c.w)
+	case c.is(http.MethodDelete, 1):
+		if len(ip.Relations.Servers) > 0 {
+			conflict(c.w, fmt.Sprintf("IP address is still linked to server %s", ip.Relations.Servers[0].ServerUUID))
+			return
+		}
+		delete(s.ips, id)
+		s.accepted(c.w)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//serveLabels handles /objects/labels
+func (s *Server) serveLabels(c *call) {
+	switch {
+	case c.is(http.MethodGet, 0):
+		list := make(map[string]gsclient.LabelProperties)
+		for label, properties := range s.labels {
+			list[label] = *properties
+		}
+		writeJSON(c.w, http.StatusOK, gsclient.LabelList{List: list})
+	case c.is(http.MethodPost, 0):
+		var body gsclient.LabelCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		if body.Label == "" {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", "label is required")
+			return
+		}
+		if _, ok := s.labels[body.Label]; ok {
+			conflict(c.w, fmt.Sprintf("label %s already exists", body.Label))
+			return
+		}
+		s.labels[body.Label] = &gsclient.LabelProperties{
+			Label:      body.Label,
+			Status:     statusActive,
+			Relations:  []interface{}{},
+			CreateTime: now(),
+			ChangeTime: now(),
+		}
+		s.created(c.w, body.Label, nil)
+	case c.is(http.MethodDelete, 1):
+		if _, ok := s.labels[c.path[0]]; !ok {
+			notFound(c.w)
+			return
+		}
+		delete(s.labels, c.path[0])
+		s.accepted(c.w)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
@@ -0,0 +1,303 @@
+package gsclienttest
+
+import (
+	"net/http"
+
+	"github.com/gridscale/gsclient-go"
+)
+
+//serveServerStorages handles /objects/servers/{id}/storages
+func (s *Server) serveServerStorages(c *call, server *gsclient.ServerProperties) {
+	switch {
+	case c.is(http.MethodGet, 2):
+		writeJSON(c.w, http.StatusOK, gsclient.ServerStorageRelationList{List: server.Relations.Storages})
+		return
+	case c.is(http.MethodPost, 2):
+		var body gsclient.ServerStorageRelationCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		storage, ok := s.storages[body.ObjectUUID]
+		if !ok {
+			notFound(c.w)
+			return
+		}
+		if findStorageRelation(server, body.ObjectUUID) >= 0 {
+			conflict(c.w, "storage is already linked to the server")
+			return
+		}
+		s.linkStorage(server, storage, body.BootDevice)
+		s.accepted(c.w)
+		return
+	case len(c.path) < 3:
+		notImplemented(c.w, c.r)
+		return
+	}
+	i := findStorageRelation(server, c.path[2])
+	if i < 0 {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 3):
+		writeJSON(c.w, http.StatusOK, gsclient.ServerStorageRelationSingle{Properties: server.Relations.Storages[i]})
+	case c.is(http.MethodPatch, 3):
+		if err := applyPatch(&server.Relations.Storages[i], c.body, "bootdevice"); err != nil {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+			return
+		}
+		s.accepted(c.w)
+	case c.is(http.MethodDelete, 3):
+		s.unlinkStorage(server, c.path[2])
+		s.accepted(c.w)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//serveServerNetworks handles /objects/servers/{id}/networks
+func (s *Server) serveServerNetworks(c *call, server *gsclient.ServerProperties) {
+	switch {
+	case c.is(http.MethodGet, 2):
+		writeJSON(c.w, http.StatusOK, gsclient.ServerNetworkRelationList{List: server.Relations.Networks})
+		return
+	case c.is(http.MethodPost, 2):
+		var body gsclient.ServerNetworkRelationCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		network, ok := s.networks[body.ObjectUUID]
+		if !ok {
+			notFound(c.w)
+			return
+		}
+		if findNetworkRelation(server, body.ObjectUUID) >= 0 {
+			conflict(c.w, "network is already linked to the server")
+			return
+		}
+		s.linkNetwork(server, network, body)
+		s.accepted(c.w)
+		return
+	case len(c.path) < 3:
+		notImplemented(c.w, c.r)
+		return
+	}
+	i := findNetworkRelation(server, c.path[2])
+	if i < 0 {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 3):
+		writeJSON(c.w, http.StatusOK, gsclient.ServerNetworkRelation{Properties: server.Relations.Networks[i]})
+	case c.is(http.MethodPatch, 3):
+		err := applyPatch(&server.Relations.Networks[i], c.body, "ordering", "bootdevice", "l3security", "firewall",
+			"firewall_template_uuid")
+		if err != nil {
+			writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+			return
+		}
+		s.accepted(c.w)
+	case c.is(http.MethodDelete, 3):
+		s.unlinkNetwork(server, c.path[2])
+		s.accepted(c.w)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//serveServerIPs handles /objects/servers/{id}/ips
+func (s *Server) serveServerIPs(c *call, server *gsclient.ServerProperties) {
+	switch {
+	case c.is(http.MethodGet, 2):
+		writeJSON(c.w, http.StatusOK, gsclient.ServerIPRelationList{List: server.Relations.PublicIPs})
+		return
+	case c.is(http.MethodPost, 2):
+		var body gsclient.ServerIPRelationCreateRequest
+		if !c.decode(&body) {
+			return
+		}
+		ip, ok := s.ips[body.ObjectUUID]
+		if !ok {
+			notFound(c.w)
+			return
+		}
+		if findIPRelation(server, body.ObjectUUID) >= 0 {
+			conflict(c.w, "IP address is already linked to the server")
+			return
+		}
+		s.linkIP(server, ip)
+		s.accepted(c.w)
+		return
+	case len(c.path) < 3:
+		notImplemented(c.w, c.r)
+		return
+	}
+	i := findIPRelation(server, c.path[2])
+	if i < 0 {
+		notFound(c.w)
+		return
+	}
+	switch {
+	case c.is(http.MethodGet, 3):
+		writeJSON(c.w, http.StatusOK, gsclient.ServerIPRelation{Properties: server.Relations.PublicIPs[i]})
+	case c.is(http.MethodDelete, 3):
+		s.unlinkIP(server, c.path[2])
+		s.accepted(c.w)
+	default:
+		notImplemented(c.w, c.r)
+	}
+}
+
+//linkStorage adds the relation between a server and a storage on both sides
+func (s *Server) linkStorage(server *gsclient.ServerProperties, storage *gsclient.StorageProperties, bootdevice bool) {
+	created := now()
+	server.Relations.Storages = append(server.Relations.Storages, gsclient.ServerStorageRelationProperties{
+		ObjectUUID:       storage.ObjectUUID,
+		ObjectName:       storage.Name,
+		Capacity:         storage.Capacity,
+		StorageType:      storage.StorageType,
+		LastUsedTemplate: storage.LastUsedTemplate,
+		BootDevice:       bootdevice,
+		ServerUUID:       server.ObjectUUID,
+		CreateTime:       created,
+	})
+	storage.Relations.Servers = append(storage.Relations.Servers, gsclient.StorageServerRelation{
+		ObjectUUID: server.ObjectUUID,
+		ObjectName: server.Name,
+		Bootdevice: bootdevice,
+		CreateTime: created,
+	})
+}
+
+//unlinkStorage removes the relation between a server and a storage on both sides
+func (s *Server) unlinkStorage(server *gsclient.ServerProperties, storageID string) {
+	if i := findStorageRelation(server, storageID); i >= 0 {
+		server.Relations.Storages = append(server.Relations.Storages[:i], server.Relations.Storages[i+1:]...)
+	}
+	storage, ok := s.storages[storageID]
+	if !ok {
+		return
+	}
+	for i, rel := range storage.Relations.Servers {
+		if rel.ObjectUUID == server.ObjectUUID {
+			storage.Relations.Servers = append(storage.Relations.Servers[:i], storage.Relations.Servers[i+1:]...)
+			return
+		}
+	}
+}
+
+//linkNetwork adds the relation between a server and a network on both sides
+func (s *Server) linkNetwork(server *gsclient.ServerProperties, network *gsclient.NetworkProperties,
+	body gsclient.ServerNetworkRelationCreateRequest) {
+	created := now()
+	relation := gsclient.ServerNetworkRelationProperties{
+		ObjectUUID:           network.ObjectUUID,
+		NetworkUUID:          network.ObjectUUID,
+		ObjectName:           network.Name,
+		ServerUUID:           server.ObjectUUID,
+		NetworkType:          network.NetworkType,
+		PublicNet:            network.PublicNet,
+		L2security:           network.L2Security,
+		BootDevice:           body.BootDevice,
+		Ordering:             body.Ordering,
+		L3security:           body.L3security,
+		FirewallTemplateUUID: body.FirewallTemplateUUID,
+		CreateTime:           created,
+	}
+	if body.Firewall != nil {
+		relation.Firewall = *body.Firewall
+	}
+	server.Relations.Networks = append(server.Relations.Networks, relation)
+	network.Relations.Servers = append(network.Relations.Servers, gsclient.NetworkServer{
+		ObjectUUID:  server.ObjectUUID,
+		ObjectName:  server.Name,
+		NetworkUUID: network.ObjectUUID,
+		Bootdevice:  body.BootDevice,
+		Ordering:    body.Ordering,
+		L3security:  body.L3security,
+		CreateTime:  created,
+	})
+}
+
+//unlinkNetwork removes the relation between a server and a network on both sides
+func (s *Server) unlinkNetwork(server *gsclient.ServerProperties, networkID string) {
+	if i := findNetworkRelation(server, networkID); i >= 0 {
+		server.Relations.Networks = append(server.Relations.Networks[:i], server.Relations.Networks[i+1:]...)
+	}
+	network, ok := s.networks[networkID]
+	if !ok {
+		return
+	}
+	for i, rel := range network.Relations.Servers {
+		if rel.ObjectUUID == server.ObjectUUID {
+			network.Relations.Servers = append(network.Relations.Servers[:i], network.Relations.Servers[i+1:]...)
+			return
+		}
+	}
+}
+
+//linkIP adds the relation between a server and an IP address on both sides
+func (s *Server) linkIP(server *gsclient.ServerProperties, ip *gsclient.IPProperties) {
+	created := now()
+	server.Relations.PublicIPs = append(server.Relations.PublicIPs, gsclient.ServerIPRelationProperties{
+		ObjectUUID: ip.ObjectUUID,
+		ServerUUID: server.ObjectUUID,
+		IP:         ip.IP,
+		Prefix:     ip.Prefix,
+		Family:     ip.Family,
+		CreateTime: created,
+	})
+	ip.Relations.Servers = append(ip.Relations.Servers, gsclient.IPServer{
+		ServerUUID: server.ObjectUUID,
+		ServerName: server.Name,
+		CreateTime: created,
+	})
+}
+
+//unlinkIP removes the relation between a server and an IP address on both sides
+func (s *Server) unlinkIP(server *gsclient.ServerProperties, ipID string) {
+	if i := findIPRelation(server, ipID); i >= 0 {
+		server.Relations.PublicIPs = append(server.Relations.PublicIPs[:i], server.Relations.PublicIPs[i+1:]...)
+	}
+	ip, ok := s.ips[ipID]
+	if !ok {
+		return
+	}
+	for i, rel := range ip.Relations.Servers {
+		if rel.ServerUUID == server.ObjectUUID {
+			ip.Relations.Servers = append(ip.Relations.Servers[:i], ip.Relations.Servers[i+1:]...)
+			return
+		}
+	}
+}
+
+//findStorageRelation returns the index of the relation to a storage, or -1
+func findStorageRelation(server *gsclient.ServerProperties, storageID string) int {
+	for i, rel := range server.Relations.Storages {
+		if rel.ObjectUUID == storageID {
+			return i
+		}
+	}
+	return -1
+}
+
+//findNetworkRelation returns the index of the relation to a network, or -1
+func findNetworkRelation(server *gsclient.ServerProperties, networkID string) int {
+	for i, rel := range server.Relations.Networks {
+		if rel.ObjectUUID == networkID {
+			return i
+		}
+	}
+	return -1
+}
+
+//findIPRelation returns the index of the relation to an IP address, or -1
+func findIPRelation(server *gsclient.ServerProperties, ipID string) int {
+	for i, rel := range server.Relations.PublicIPs {
+		if rel.ObjectUUID == ipID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,337 @@
+//Package gsclienttest provides an in-process fake of the gridscale API, so code using gsclient can be tested
+//end to end without a gridscale account.
+//
+//The fake keeps servers, storages, networks, IP addresses, the relations between them, storage snapshots,
+//labels and request statuses in memory. New objects are "in-provisioning" until they have been read
+//(see Server.ProvisioningReads), requests are "pending" until they have been checked (see Server.RequestReads),
+//deleted objects return 404, and linking or unlinking objects updates both sides of the relation. Endpoints the
+//fake does not implement return 501.
+//
+//	fake := gsclienttest.NewServer()
+//	defer fake.Close()
+//	client := fake.Client(gsclient.WithSync(true))
+package gsclienttest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gridscale/gsclient-go"
+)
+
+//Credentials and location accepted by the fake API
+const (
+	//UserUUID is the user UUID accepted by the fake API
+	UserUUID = "0a4b47fd-5ed8-4c95-bd28-1f7e9b0b9a8c"
+
+	//APIToken is the API token accepted by the fake API
+	APIToken = "gsclienttest-token"
+
+	//LocationUUID is the location of objects which are created without a location
+	LocationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
+)
+
+const (
+	statusInProvisioning = "in-provisioning"
+	statusActive         = "active"
+	requestPending       = "pending"
+	requestDone          = "done"
+	requestFailed        = "failed"
+	gsTimeLayout         = "2006-01-02T15:04:05Z"
+)
+
+//Server is a stateful fake of the gridscale API running on a local httptest.Server
+type Server struct {
+	*httptest.Server
+
+	//ProvisioningReads is the number of times a new object is returned as "in-provisioning"
+	//before it becomes "active". Default: 1.
+	ProvisioningReads int
+
+	//RequestReads is the number of times a request is returned as "pending" before it is "done". Default: 1.
+	RequestReads int
+
+	mu          sync.Mutex
+	servers     map[string]*gsclient.ServerProperties
+	storages    map[string]*gsclient.StorageProperties
+	networks    map[string]*gsclient.NetworkProperties
+	ips         map[string]*gsclient.IPProperties
+	snapshots   map[string]*gsclient.StorageSnapshotProperties
+	labels      map[string]*gsclient.LabelProperties
+	requests    map[string]*request
+	pending     map[string]int
+	failMessage *string
+	ipCounter   int
+}
+
+//request is a request tracked by the fake API
+type request struct {
+	properties gsclient.RequestStatusProperties
+
+	//number of times the request is still returned as pending
+	pending int
+}
+
+//apiError is the body of an error response
+type apiError struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+//NewServer starts a new fake API. It has to be closed by calling Close.
+func NewServer() *Server {
+	s := &Server{
+		ProvisioningReads: 1,
+		RequestReads:      1,
+		servers:           make(map[string]*gsclient.ServerProperties),
+		storages:          make(map[string]*gsclient.StorageProperties),
+		networks:          make(map[string]*gsclient.NetworkProperties),
+		ips:               make(map[string]*gsclient.IPProperties),
+		snapshots:         make(map[string]*gsclient.StorageSnapshotProperties),
+		labels:            make(map[string]*gsclient.LabelProperties),
+		requests:          make(map[string]*request),
+		pending:           make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+//Config returns a configuration for the fake API. The options are applied after the defaults,
+//which use short polling intervals to keep tests fast.
+func (s *Server) Config(opts ...gsclient.Option) *gsclient.Config {
+	defaults := []gsclient.Option{
+		gsclient.WithAPIURL(s.URL),
+		gsclient.WithCredentials(UserUUID, APIToken),
+		gsclient.WithTimeouts(10*time.Second, 10*time.Millisecond),
+	}
+	return gsclient.NewConfig(append(defaults, opts...)...)
+}
+
+//Client returns a client talking to the fake API, see Config
+func (s *Server) Client(opts ...gsclient.Option) *gsclient.Client {
+	return gsclient.NewClient(s.Config(opts...))
+}
+
+//FailNextRequest makes the next request changing an object end up as failed, with the given message.
+//The change itself is still applied.
+func (s *Server) FailNextRequest(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failMessage = &message
+}
+
+//serveHTTP checks the credentials and dispatches the request
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Auth-UserID") != UserUUID || r.Header.Get("X-Auth-Token") != APIToken {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "invalid X-Auth-UserID or X-Auth-Token")
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Bad Request", err.Error())
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) == 2 && segments[0] == "requests" {
+		s.getRequest(w, r, segments[1])
+		return
+	}
+	if len(segments) < 2 || segments[0] != "objects" {
+		notImplemented(w, r)
+		return
+	}
+	call := &call{w: w, r: r, body: body, path: segments[2:]}
+	switch segments[1] {
+	case "servers":
+		s.serveServers(call)
+	case "storages":
+		s.serveStorages(call)
+	case "networks":
+		s.serveNetworks(call)
+	case "ips":
+		s.serveIPs(call)
+	case "labels":
+		s.serveLabels(call)
+	default:
+		notImplemented(w, r)
+	}
+}
+
+//call is a request to an object endpoint
+type call struct {
+	w    http.ResponseWriter
+	r    *http.Request
+	body []byte
+
+	//path segments after the object type, e.g. [id, "storages"]
+	path []string
+}
+
+//is reports whether the call has the given method and number of path segments
+func (c *call) is(method string, segments int) bool {
+	return c.r.Method == method && len(c.path) == segments
+}
+
+//decode decodes the request body, it writes an error response on failure
+func (c *call) decode(v interface{}) bool {
+	if err := json.Unmarshal(c.body, v); err != nil {
+		writeError(c.w, http.StatusBadRequest, "Bad Request", err.Error())
+		return false
+	}
+	return true
+}
+
+//getRequest returns the status of a request
+func (s *Server) getRequest(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		notImplemented(w, r)
+		return
+	}
+	req, ok := s.requests[id]
+	if !ok {
+		notFound(w)
+		return
+	}
+	if req.properties.Status == requestPending {
+		if req.pending > 0 {
+			req.pending--
+		} else {
+			req.properties.Status = requestDone
+		}
+	}
+	writeJSON(w, http.StatusOK, gsclient.RequestStatus{id: req.properties})
+}
+
+//newRequest tracks a new request and sets its UUID as response header
+func (s *Server) newRequest(w http.ResponseWriter) string {
+	id := uuid.New().String()
+	req := &request{
+		properties: gsclient.RequestStatusProperties{
+			Status:     requestPending,
+			CreateTime: now(),
+		},
+		pending: s.RequestReads,
+	}
+	if s.failMessage != nil {
+		req.properties.Status = requestFailed
+		req.properties.Message = *s.failMessage
+		s.failMessage = nil
+	}
+	s.requests[id] = req
+	w.Header().Set("X-Request-Id", id)
+	return id
+}
+
+//accepted answers a request which changed an object without returning a body
+func (s *Server) accepted(w http.ResponseWriter) {
+	s.newRequest(w)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//created answers a request which created an object
+func (s *Server) created(w http.ResponseWriter, objectUUID string, extra map[string]interface{}) {
+	response := map[string]interface{}{
+		"object_uuid":  objectUUID,
+		"request_uuid": s.newRequest(w),
+	}
+	for k, v := range extra {
+		response[k] = v
+	}
+	writeJSON(w, http.StatusCreated, response)
+}
+
+//provision marks a new object as being in provisioning
+func (s *Server) provision(id string) string {
+	s.pending[id] = s.ProvisioningReads
+	return statusInProvisioning
+}
+
+//observe is called whenever an object is read. It makes the object active after it has been
+//read ProvisioningReads times.
+func (s *Server) observe(id string, status *string) {
+	if *status != statusInProvisioning {
+		return
+	}
+	if s.pending[id] > 0 {
+		s.pending[id]--
+		return
+	}
+	delete(s.pending, id)
+	*status = statusActive
+}
+
+//applyPatch copies the allowed fields of a JSON patch into the properties of an object
+func applyPatch(properties interface{}, patch []byte, allowed ...string) error {
+	var changes map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return err
+	}
+	current, err := json.Marshal(properties)
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(current, &fields); err != nil {
+		return err
+	}
+	for _, key := range allowed {
+		if value, ok := changes[key]; ok {
+			fields[key] = value
+		}
+	}
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(merged, properties)
+}
+
+//locationOrDefault returns the location, or LocationUUID if it is empty
+func locationOrDefault(location string) string {
+	if location == "" {
+		return LocationUUID
+	}
+	return location
+}
+
+//now returns the current time with the precision of the API
+func now() gsclient.GSTime {
+	t, _ := time.Parse(gsTimeLayout, time.Now().UTC().Format(gsTimeLayout))
+	return gsclient.GSTime{Time: t}
+}
+
+//writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+//writeError writes an error response the way the API does
+func writeError(w http.ResponseWriter, statusCode int, title, description string) {
+	writeJSON(w, statusCode, apiError{Title: title, Description: description})
+}
+
+//notFound writes a 404 response
+func notFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not Found", "the requested object does not exist")
+}
+
+//conflict writes a 409 response
+func conflict(w http.ResponseWriter, description string) {
+	writeError(w, http.StatusConflict, "Conflict", description)
+}
+
+//notImplemented writes a 501 response for endpoints the fake API does not know. It must not be a 404, otherwise
+//waiting for an object to be deleted would succeed on endpoints the fake does not implement.
+func notImplemented(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotImplemented, "Not Implemented", r.Method+" "+r.URL.Path+" is not implemented by gsclienttest")
+}
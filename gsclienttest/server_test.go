@@ -0,0 +1,266 @@
+package gsclienttest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gridscale/gsclient-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var emptyCtx = context.Background()
+
+func TestServer_Provisioning(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	fake.ProvisioningReads = 2
+	client := fake.Client()
+
+	res, op, err := client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "test", Capacity: 10})
+	assert.Nil(t, err)
+	assert.False(t, op.Done())
+	for i := 0; i < 2; i++ {
+		storage, err := client.GetStorage(emptyCtx, res.ObjectUUID)
+		assert.Nil(t, err)
+		assert.Equal(t, "in-provisioning", storage.Properties.Status)
+	}
+	storage, err := client.GetStorage(emptyCtx, res.ObjectUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "active", storage.Properties.Status)
+	assert.Equal(t, LocationUUID, storage.Properties.LocationUUID)
+
+	assert.Nil(t, op.Wait(emptyCtx))
+	status, err := client.GetRequestStatus(emptyCtx, op.RequestUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "done", status.Status)
+}
+
+func TestServer_PollOperation(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	fake.RequestReads = 2
+	client := fake.Client()
+
+	_, op, err := client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "test", Capacity: 10})
+	assert.Nil(t, err)
+	//the operation is done once the fake has returned the request as pending RequestReads times
+	for i := 0; i < 2; i++ {
+		status, err := op.Poll(emptyCtx)
+		assert.Nil(t, err)
+		assert.Equal(t, gsclient.OperationPending, status)
+	}
+	status, err := op.Poll(emptyCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, gsclient.OperationSucceeded, status)
+	assert.True(t, op.Done())
+
+	fake.FailNextRequest("no capacity left")
+	_, op, err = client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "failed", Capacity: 10})
+	assert.Nil(t, err)
+	for i := 0; i < 3 && !op.Done(); i++ {
+		_, err = op.Poll(emptyCtx)
+	}
+	assert.Equal(t, gsclient.OperationFailed, op.Status())
+	assert.True(t, errors.Is(err, gsclient.ErrRequestFailed))
+	assert.Equal(t, err, op.Err())
+}
+
+func TestServer_NotFoundAfterDelete(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithSync(true))
+
+	res, _, err := client.CreateNetwork(emptyCtx, gsclient.NetworkCreateRequest{Name: "test"})
+	assert.Nil(t, err)
+	_, err = client.UpdateNetwork(emptyCtx, res.ObjectUUID, gsclient.NetworkUpdateRequest{Name: gsclient.String("renamed")})
+	assert.Nil(t, err)
+	network, err := client.GetNetwork(emptyCtx, res.ObjectUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", network.Properties.Name)
+
+	_, err = client.DeleteNetwork(emptyCtx, res.ObjectUUID)
+	assert.Nil(t, err)
+	_, err = client.GetNetwork(emptyCtx, res.ObjectUUID)
+	assert.True(t, errors.Is(err, gsclient.ErrNotFound))
+	networks, err := client.GetNetworkList(emptyCtx)
+	assert.Nil(t, err)
+	assert.Empty(t, networks)
+}
+
+func TestServer_Relations(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithSync(true))
+
+	server, _, err := client.CreateServer(emptyCtx, gsclient.ServerCreateRequest{Name: "server", Cores: 1, Memory: 2})
+	assert.Nil(t, err)
+	storage, _, err := client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "storage", Capacity: 10})
+	assert.Nil(t, err)
+	network, _, err := client.CreateNetwork(emptyCtx, gsclient.NetworkCreateRequest{Name: "network"})
+	assert.Nil(t, err)
+	ip, _, err := client.CreateIP(emptyCtx, gsclient.IPCreateRequest{Name: "ip", Family: gsclient.IPv4Type})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, ip.IP)
+
+	_, err = client.LinkStorage(emptyCtx, server.ObjectUUID, storage.ObjectUUID, true)
+	assert.Nil(t, err)
+	_, err = client.LinkNetwork(emptyCtx, server.ObjectUUID, network.ObjectUUID, "", false, 0, nil, nil)
+	assert.Nil(t, err)
+	_, err = client.LinkIP(emptyCtx, server.ObjectUUID, ip.ObjectUUID)
+	assert.Nil(t, err)
+	_, err = client.LinkStorage(emptyCtx, server.ObjectUUID, storage.ObjectUUID, true)
+	assert.True(t, errors.Is(err, gsclient.ErrConflict))
+
+	s, err := client.GetServer(emptyCtx, server.ObjectUUID)
+	assert.Nil(t, err)
+	assert.Len(t, s.Properties.Relations.Storages, 1)
+	assert.Len(t, s.Properties.Relations.Networks, 1)
+	assert.Len(t, s.Properties.Relations.PublicIPs, 1)
+	relation, err := client.GetServerStorage(emptyCtx, server.ObjectUUID, storage.ObjectUUID)
+	assert.Nil(t, err)
+	assert.True(t, relation.BootDevice)
+	st, err := client.GetStorage(emptyCtx, storage.ObjectUUID)
+	assert.Nil(t, err)
+	if assert.Len(t, st.Properties.Relations.Servers, 1) {
+		assert.Equal(t, server.ObjectUUID, st.Properties.Relations.Servers[0].ObjectUUID)
+	}
+
+	//linked objects cannot be deleted
+	_, err = client.DeleteStorage(emptyCtx, storage.ObjectUUID)
+	assert.True(t, errors.Is(err, gsclient.ErrConflict))
+
+	_, err = client.UnlinkStorage(emptyCtx, server.ObjectUUID, storage.ObjectUUID)
+	assert.Nil(t, err)
+	_, err = client.GetServerStorage(emptyCtx, server.ObjectUUID, storage.ObjectUUID)
+	assert.True(t, errors.Is(err, gsclient.ErrNotFound))
+	_, err = client.DeleteStorage(emptyCtx, storage.ObjectUUID)
+	assert.Nil(t, err)
+
+	//deleting the server removes its remaining relations
+	_, err = client.DeleteServer(emptyCtx, server.ObjectUUID)
+	assert.Nil(t, err)
+	n, err := client.GetNetwork(emptyCtx, network.ObjectUUID)
+	assert.Nil(t, err)
+	assert.Empty(t, n.Properties.Relations.Servers)
+	_, err = client.DeleteIP(emptyCtx, ip.ObjectUUID)
+	assert.Nil(t, err)
+}
+
+func TestServer_Power(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithSync(true))
+
+	server, _, err := client.CreateServer(emptyCtx, gsclient.ServerCreateRequest{Name: "server", Cores: 1, Memory: 2})
+	assert.Nil(t, err)
+	_, err = client.StartServer(emptyCtx, server.ObjectUUID)
+	assert.Nil(t, err)
+	isOn, err := client.IsServerOn(emptyCtx, server.ObjectUUID)
+	assert.Nil(t, err)
+	assert.True(t, isOn)
+	_, err = client.ShutdownServer(emptyCtx, server.ObjectUUID)
+	assert.Nil(t, err)
+	isOn, err = client.IsServerOn(emptyCtx, server.ObjectUUID)
+	assert.Nil(t, err)
+	assert.False(t, isOn)
+}
+
+func TestServer_Snapshots(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithSync(true))
+
+	storage, _, err := client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "storage", Capacity: 10})
+	assert.Nil(t, err)
+	snapshot, _, err := client.CreateStorageSnapshot(emptyCtx, storage.ObjectUUID, gsclient.StorageSnapshotCreateRequest{
+		Name: "snapshot",
+	})
+	assert.Nil(t, err)
+	snapshots, err := client.GetStorageSnapshotList(emptyCtx, storage.ObjectUUID)
+	assert.Nil(t, err)
+	assert.Len(t, snapshots, 1)
+	_, err = client.RollbackStorage(emptyCtx, storage.ObjectUUID, snapshot.ObjectUUID, gsclient.StorageRollbackRequest{
+		Rollback: true,
+	})
+	assert.Nil(t, err)
+	_, err = client.DeleteStorageSnapshot(emptyCtx, storage.ObjectUUID, snapshot.ObjectUUID)
+	assert.Nil(t, err)
+	_, err = client.GetStorageSnapshot(emptyCtx, storage.ObjectUUID, snapshot.ObjectUUID)
+	assert.True(t, errors.Is(err, gsclient.ErrNotFound))
+}
+
+func TestServer_Labels(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithSync(true))
+
+	_, _, err := client.CreateLabel(emptyCtx, gsclient.LabelCreateRequest{Label: "test"})
+	assert.Nil(t, err)
+	_, _, err = client.CreateLabel(emptyCtx, gsclient.LabelCreateRequest{Label: "test"})
+	assert.True(t, errors.Is(err, gsclient.ErrConflict))
+	labels, err := client.GetLabelList(emptyCtx)
+	assert.Nil(t, err)
+	assert.Len(t, labels, 1)
+	_, err = client.DeleteLabel(emptyCtx, "test")
+	assert.Nil(t, err)
+	labels, err = client.GetLabelList(emptyCtx)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+}
+
+func TestServer_FailNextRequest(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithSync(true))
+
+	fake.FailNextRequest("no capacity left")
+	_, _, err := client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "storage", Capacity: 10})
+	var statusError gsclient.RequestStatusError
+	if assert.True(t, errors.As(err, &statusError)) {
+		assert.Equal(t, "no capacity left", statusError.Message)
+	}
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithCredentials(UserUUID, "wrong"))
+	_, err := client.GetServerList(emptyCtx)
+	var requestError gsclient.RequestError
+	if assert.True(t, errors.As(err, &requestError)) {
+		assert.Equal(t, 401, requestError.StatusCode)
+	}
+}
+
+func TestServer_NotImplemented(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithRetryPolicy(&gsclient.ExponentialBackoffRetryPolicy{}))
+	_, err := client.GetTemplateList(emptyCtx)
+	assert.False(t, errors.Is(err, gsclient.ErrNotFound))
+	var requestError gsclient.RequestError
+	if assert.True(t, errors.As(err, &requestError)) {
+		assert.Equal(t, 501, requestError.StatusCode)
+	}
+}
+
+func TestServer_UpdateFunc(t *testing.T) {
+	fake := NewServer()
+	defer fake.Close()
+	client := fake.Client(gsclient.WithSync(true))
+
+	res, _, err := client.CreateStorage(emptyCtx, gsclient.StorageCreateRequest{Name: "storage", Capacity: 10,
+		Labels: []string{"a"}})
+	assert.Nil(t, err)
+	_, err = client.UpdateStorageFunc(emptyCtx, res.ObjectUUID, func(properties *gsclient.StorageProperties) error {
+		properties.Capacity += 10
+		properties.Labels = append(properties.Labels, "b")
+		return nil
+	})
+	assert.Nil(t, err)
+	storage, err := client.GetStorage(emptyCtx, res.ObjectUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, storage.Properties.Capacity)
+	assert.Equal(t, []string{"a", "b"}, storage.Properties.Labels)
+}
@@ -0,0 +1,260 @@
+//Command mockgen generates the mocks of the gsclientmock package from the operator interfaces of gsclient.
+//It is run from the root of the repository by go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+//method is a method of an operator interface
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+//param is a parameter of a method
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+func main() {
+	output := flag.String("o", "gsclientmock/mocks.go", "output file")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, ok := pkgs["gsclient"]
+	if !ok {
+		log.Fatal("package gsclient not found, mockgen has to be run from the root of the repository")
+	}
+	interfaces := make(map[string]*ast.InterfaceType)
+	for _, file := range pkg.Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if iface, ok := spec.Type.(*ast.InterfaceType); ok && strings.HasSuffix(spec.Name.Name, "Operator") {
+				interfaces[spec.Name.Name] = iface
+			}
+			return false
+		})
+	}
+	var names []string
+	for name := range interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/mockgen. DO NOT EDIT.\n\n")
+	buf.WriteString("package gsclientmock\n\n")
+	buf.WriteString("import (\n\t\"context\"\n\n\t\"github.com/gridscale/gsclient-go\"\n)\n")
+	for _, name := range names {
+		writeMock(&buf, name, collectMethods(interfaces, interfaces[name]))
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("invalid generated code: %v\n%s", err, buf.Bytes())
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+//collectMethods returns the methods of an interface including the ones of embedded interfaces
+func collectMethods(interfaces map[string]*ast.InterfaceType, iface *ast.InterfaceType) []method {
+	var methods []method
+	for _, field := range iface.Methods.List {
+		if len(field.Names) == 0 {
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok || interfaces[ident.Name] == nil {
+				log.Fatalf("unsupported embedded interface %s", typeString(field.Type))
+			}
+			methods = append(methods, collectMethods(interfaces, interfaces[ident.Name])...)
+			continue
+		}
+		fn := field.Type.(*ast.FuncType)
+		m := method{name: field.Names[0].Name}
+		for _, p := range fn.Params.List {
+			_, variadic := p.Type.(*ast.Ellipsis)
+			typ := typeString(p.Type)
+			if len(p.Names) == 0 {
+				m.params = append(m.params, param{name: fmt.Sprintf("p%d", len(m.params)), typ: typ, variadic: variadic})
+			}
+			for _, n := range p.Names {
+				m.params = append(m.params, param{name: n.Name, typ: typ, variadic: variadic})
+			}
+		}
+		if fn.Results != nil {
+			for _, r := range fn.Results.List {
+				for i := 0; i < len(r.Names) || i == 0; i++ {
+					m.results = append(m.results, typeString(r.Type))
+				}
+			}
+		}
+		methods = append(methods, m)
+	}
+	return methods
+}
+
+//writeMock writes the mock of an interface
+func writeMock(buf *bytes.Buffer, name string, methods []method) {
+	fields := funcFields(methods)
+	fmt.Fprintf(buf, "\n//%s is a mock of gsclient.%s\n", name, name)
+	fmt.Fprintf(buf, "type %s struct {\n\tRecorder\n", name)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\n\t//%s is called by %s if it is set\n", fields[m.name], m.name)
+		fmt.Fprintf(buf, "\t%s func(%s) %s\n", fields[m.name], m.paramList(), m.resultList(false))
+	}
+	buf.WriteString("}\n")
+	fmt.Fprintf(buf, "\nvar _ gsclient.%s = (*%s)(nil)\n", name, name)
+	for _, m := range methods {
+		field := fields[m.name]
+		fmt.Fprintf(buf, "\n//%s records the call and returns the result of %s, or zero values if it is not set\n",
+			m.name, field)
+		fmt.Fprintf(buf, "func (m *%s) %s(%s) %s {\n", name, m.name, m.paramList(), m.resultList(true))
+		fmt.Fprintf(buf, "\tm.record(%q%s)\n", m.name, m.argList(true))
+		fmt.Fprintf(buf, "\tif m.%s != nil {\n", field)
+		if len(m.results) > 0 {
+			fmt.Fprintf(buf, "\t\treturn m.%s(%s)\n", field, strings.TrimPrefix(m.argList(false), ", "))
+		} else {
+			fmt.Fprintf(buf, "\t\tm.%s(%s)\n", field, strings.TrimPrefix(m.argList(false), ", "))
+		}
+		buf.WriteString("\t}\n\treturn\n}\n")
+	}
+}
+
+//funcFields returns the names of the function fields of a mock by method name. The field of method Xxx is
+//called XxxFunc, or XxxFn if the interface has a method called XxxFunc as well (e.g. UpdateServer and
+//UpdateServerFunc).
+func funcFields(methods []method) map[string]string {
+	names := make(map[string]bool)
+	for _, m := range methods {
+		names[m.name] = true
+	}
+	fields := make(map[string]string)
+	for _, m := range methods {
+		if names[m.name+"Func"] {
+			fields[m.name] = m.name + "Fn"
+		} else {
+			fields[m.name] = m.name + "Func"
+		}
+	}
+	return fields
+}
+
+//paramList returns the parameters of a method declaration
+func (m method) paramList() string {
+	var params []string
+	for _, p := range m.params {
+		params = append(params, p.name+" "+p.typ)
+	}
+	return strings.Join(params, ", ")
+}
+
+//resultList returns the results of a method declaration, named if the zero values are returned
+func (m method) resultList(named bool) string {
+	var results []string
+	for i, r := range m.results {
+		if named {
+			r = fmt.Sprintf("r%d %s", i, r)
+		}
+		results = append(results, r)
+	}
+	if len(results) == 1 && !named {
+		return results[0]
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+//argList returns the arguments passing the parameters on, with a leading comma if there are any.
+//Variadic arguments are recorded as a slice.
+func (m method) argList(record bool) string {
+	var args string
+	for _, p := range m.params {
+		args += ", " + p.name
+		if p.variadic && !record {
+			args += "..."
+		}
+	}
+	return args
+}
+
+//typeString returns the source of a type, types of package gsclient are qualified with the package name
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "gsclient." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			log.Fatalf("unsupported array type")
+		}
+		return "[]" + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt)
+	case *ast.ChanType:
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + typeString(t.Value)
+		case ast.RECV:
+			return "<-chan " + typeString(t.Value)
+		}
+		return "chan " + typeString(t.Value)
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	case *ast.FuncType:
+		var params, results []string
+		for _, p := range t.Params.List {
+			for i := 0; i < len(p.Names) || i == 0; i++ {
+				params = append(params, typeString(p.Type))
+			}
+		}
+		if t.Results != nil {
+			for _, r := range t.Results.List {
+				for i := 0; i < len(r.Names) || i == 0; i++ {
+					results = append(results, typeString(r.Type))
+				}
+			}
+		}
+		switch len(results) {
+		case 0:
+			return "func(" + strings.Join(params, ", ") + ")"
+		case 1:
+			return "func(" + strings.Join(params, ", ") + ") " + results[0]
+		}
+		return "func(" + strings.Join(params, ", ") + ") (" + strings.Join(results, ", ") + ")"
+	}
+	log.Fatalf("unsupported type %T", expr)
+	return ""
+}
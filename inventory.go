@@ -0,0 +1,487 @@
+package gsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//defaultInventoryInterval is the default interval of polling the events of an inventory
+const defaultInventoryInterval = 10 * time.Second
+
+//inventoryTypes are the object types an inventory can mirror
+var inventoryTypes = []string{"server", "storage", "network", "ip", "snapshot", "loadbalancer"}
+
+//eventObjectTypes maps the object types of events (lower case, without separators) to the object types of an inventory
+var eventObjectTypes = map[string]string{
+	"server":          "server",
+	"storage":         "storage",
+	"network":         "network",
+	"ip":              "ip",
+	"ipaddress":       "ip",
+	"snapshot":        "snapshot",
+	"storagesnapshot": "snapshot",
+	"loadbalancer":    "loadbalancer",
+}
+
+//InventoryObject is an object mirrored by an Inventory
+type InventoryObject struct {
+	//Type of the object: "server", "storage", "network", "ip", "snapshot" or "loadbalancer"
+	ObjectType string
+
+	//UUID of the object
+	ObjectUUID string
+
+	//The object, i.e. a Server, Storage, Network, IP, StorageSnapshot or LoadBalancer
+	Object interface{}
+}
+
+//Labels returns the labels of the object
+func (o InventoryObject) Labels() []string {
+	labels, _ := propertyOf(o.Object, "Labels").([]string)
+	return labels
+}
+
+//LocationUUID returns the UUID of the location of the object
+func (o InventoryObject) LocationUUID() string {
+	locationUUID, _ := propertyOf(o.Object, "LocationUUID").(string)
+	return locationUUID
+}
+
+//InventoryHandler is notified about the changes of an Inventory. Functions which are not set are skipped.
+type InventoryHandler struct {
+	//OnAdd is called when an object has been added
+	OnAdd func(object InventoryObject)
+
+	//OnUpdate is called when the properties of an object have changed
+	OnUpdate func(old, new InventoryObject)
+
+	//OnDelete is called when an object has been deleted
+	OnDelete func(object InventoryObject)
+}
+
+//InventoryOptions configures an Inventory
+type InventoryOptions struct {
+	//Object types which are mirrored, by default all of them ("server", "storage", "network", "ip", "snapshot"
+	//and "loadbalancer")
+	ObjectTypes []string
+
+	//Interval of polling the events, default 10s
+	Interval time.Duration
+}
+
+//Inventory is an in-memory mirror of the objects of a project. It is filled by a full sync, afterwards only the
+//objects named in new events are read again:
+//
+//	inventory := gsclient.NewInventory(client, gsclient.InventoryOptions{})
+//	inventory.AddHandler(gsclient.InventoryHandler{
+//		OnDelete: func(object gsclient.InventoryObject) { ... },
+//	})
+//	go inventory.Run(ctx)
+//
+//All functions of an inventory are safe for concurrent use.
+type Inventory struct {
+	client      *Client
+	objectTypes []string
+	interval    time.Duration
+
+	//syncMu serializes syncing and polling, and guards cursor
+	syncMu sync.Mutex
+	cursor eventCursor
+
+	//mu guards objects, handlers and synced
+	mu       sync.RWMutex
+	objects  map[string]InventoryObject
+	handlers []InventoryHandler
+	synced   bool
+}
+
+//NewInventory creates an empty inventory of the objects the client can access. It is filled by Run or Sync.
+func NewInventory(client *Client, options InventoryOptions) *Inventory {
+	inventory := &Inventory{
+		client:      client,
+		objectTypes: options.ObjectTypes,
+		interval:    options.Interval,
+		objects:     make(map[string]InventoryObject),
+	}
+	if len(inventory.objectTypes) == 0 {
+		inventory.objectTypes = inventoryTypes
+	}
+	if inventory.interval <= 0 {
+		inventory.interval = defaultInventoryInterval
+	}
+	return inventory
+}
+
+//AddHandler registers a handler which is notified about all following changes. Handlers are called one after
+//another, in the order the changes are applied, and must not block.
+func (inv *Inventory) AddHandler(handler InventoryHandler) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.handlers = append(inv.handlers, handler)
+}
+
+//Run syncs the inventory and then polls the events until the context is done. Errors of polling are logged,
+//and the events are polled again in the next interval. An error of the initial sync is returned.
+func (inv *Inventory) Run(ctx context.Context) error {
+	if err := inv.Sync(ctx); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(inv.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if err := inv.Poll(ctx); err != nil && ctx.Err() == nil {
+			inv.client.cfg.logger.Warn("Polling the events of the inventory failed", Fields{"error": err})
+		}
+	}
+}
+
+//HasSynced reports whether the inventory has been synced at least once
+func (inv *Inventory) HasSynced() bool {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	return inv.synced
+}
+
+//Sync reads all objects and replaces the content of the inventory with them
+func (inv *Inventory) Sync(ctx context.Context) error {
+	inv.syncMu.Lock()
+	defer inv.syncMu.Unlock()
+	return inv.sync(WithoutCache(ctx))
+}
+
+//sync reads all objects, the caller has to hold syncMu
+func (inv *Inventory) sync(ctx context.Context) error {
+	for _, objectType := range inv.objectTypes {
+		if !containsString(inventoryTypes, objectType) {
+			return newArgumentError(fmt.Sprintf("objects of type %s cannot be mirrored", objectType), "ObjectTypes")
+		}
+	}
+	//the events are read first, so that no change made while listing the objects is missed
+	events, err := inv.client.GetEventList(ctx)
+	if err != nil {
+		return err
+	}
+	objects := make(map[string]InventoryObject)
+	for _, objectType := range inv.objectTypes {
+		if err := inv.list(ctx, objectType, objects); err != nil {
+			return err
+		}
+	}
+	inv.cursor.next(events)
+	inv.replace(objects, inv.objectTypes)
+	inv.mu.Lock()
+	inv.synced = true
+	inv.mu.Unlock()
+	return nil
+}
+
+//Poll reads the events which are new since the last sync or poll, and reads the objects named in them again.
+//Objects which are not found anymore are deleted. The inventory is synced first if it has not been synced yet.
+//
+//If reading an object fails, the same events are processed again by the next poll.
+func (inv *Inventory) Poll(ctx context.Context) error {
+	inv.syncMu.Lock()
+	defer inv.syncMu.Unlock()
+	ctx = WithoutCache(ctx)
+	if !inv.HasSynced() {
+		return inv.sync(ctx)
+	}
+	events, err := inv.client.GetEventList(ctx)
+	if err != nil {
+		return err
+	}
+	cursor := inv.cursor.clone()
+	var uuids []string
+	objectTypes := make(map[string]string)
+	for _, event := range cursor.next(events) {
+		if _, ok := objectTypes[event.ObjectUUID]; ok || event.ObjectUUID == "" {
+			continue
+		}
+		uuids = append(uuids, event.ObjectUUID)
+		objectTypes[event.ObjectUUID] = eventObjectTypes[normalizeObjectType(event.ObjectType)]
+	}
+	var listSnapshots bool
+	for _, uuid := range uuids {
+		known, ok := inv.Get(uuid)
+		objectType := objectTypes[uuid]
+		if ok {
+			objectType = known.ObjectType
+		}
+		if !containsString(inv.objectTypes, objectType) {
+			continue
+		}
+		object, err := inv.fetch(ctx, objectType, uuid, known)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			inv.remove(uuid)
+		case err != nil:
+			return err
+		case object == nil:
+			//snapshots can only be read with the UUID of their storage
+			listSnapshots = true
+		default:
+			inv.set(InventoryObject{ObjectType: objectType, ObjectUUID: uuid, Object: object})
+		}
+	}
+	if listSnapshots {
+		objects := make(map[string]InventoryObject)
+		if err := inv.list(ctx, "snapshot", objects); err != nil {
+			return err
+		}
+		inv.replace(objects, []string{"snapshot"})
+	}
+	inv.cursor = cursor
+	return nil
+}
+
+//normalizeObjectType converts the object type of an event to lower case and removes separators
+func normalizeObjectType(objectType string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(objectType))
+}
+
+//list reads all objects of a type and adds them to objects
+func (inv *Inventory) list(ctx context.Context, objectType string, objects map[string]InventoryObject) error {
+	var list interface{}
+	var err error
+	switch objectType {
+	case "server":
+		list, err = inv.client.GetServerList(ctx)
+	case "storage":
+		list, err = inv.client.GetStorageList(ctx)
+	case "network":
+		list, err = inv.client.GetNetworkList(ctx)
+	case "ip":
+		list, err = inv.client.GetIPList(ctx)
+	case "loadbalancer":
+		list, err = inv.client.GetLoadBalancerList(ctx)
+	case "snapshot":
+		list, err = inv.listSnapshots(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(list)
+	for i := 0; i < v.Len(); i++ {
+		object := v.Index(i).Interface()
+		uuid, _ := propertyOf(object, "ObjectUUID").(string)
+		objects[uuid] = InventoryObject{ObjectType: objectType, ObjectUUID: uuid, Object: object}
+	}
+	return nil
+}
+
+//listSnapshots reads the snapshots of all storages
+func (inv *Inventory) listSnapshots(ctx context.Context) ([]StorageSnapshot, error) {
+	storages, err := inv.client.GetStorageList(ctx, ListOptions{Fields: []string{"object_uuid"}})
+	if err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	var snapshots []StorageSnapshot
+	err = inv.client.BulkExecute(ctx, len(storages), func(ctx context.Context, i int) error {
+		list, err := inv.client.GetStorageSnapshotList(ctx, storages[i].Properties.ObjectUUID)
+		if errors.Is(err, ErrNotFound) {
+			//the storage has been deleted in the meantime
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		snapshots = append(snapshots, list...)
+		return err
+	})
+	return snapshots, err
+}
+
+//fetch reads a single object. It returns nil without error for snapshots whose storage is unknown.
+func (inv *Inventory) fetch(ctx context.Context, objectType, uuid string, known InventoryObject) (interface{}, error) {
+	switch objectType {
+	case "server":
+		return inv.client.GetServer(ctx, uuid)
+	case "storage":
+		return inv.client.GetStorage(ctx, uuid)
+	case "network":
+		return inv.client.GetNetwork(ctx, uuid)
+	case "ip":
+		return inv.client.GetIP(ctx, uuid)
+	case "loadbalancer":
+		return inv.client.GetLoadBalancer(ctx, uuid)
+	case "snapshot":
+		snapshot, ok := known.Object.(StorageSnapshot)
+		if !ok {
+			return nil, nil
+		}
+		return inv.client.GetStorageSnapshot(ctx, snapshot.Properties.ParentUUID, uuid)
+	}
+	return nil, nil
+}
+
+//set adds or updates an object
+func (inv *Inventory) set(object InventoryObject) {
+	inv.mu.Lock()
+	old, ok := inv.objects[object.ObjectUUID]
+	inv.objects[object.ObjectUUID] = object
+	handlers := inv.handlers
+	inv.mu.Unlock()
+	if ok {
+		notifyUpdate(handlers, old, object)
+	} else {
+		notifyAdd(handlers, object)
+	}
+}
+
+//remove deletes an object. The snapshots of a deleted storage are deleted as well.
+func (inv *Inventory) remove(uuid string) {
+	inv.mu.Lock()
+	object, ok := inv.objects[uuid]
+	if !ok {
+		inv.mu.Unlock()
+		return
+	}
+	deleted := []InventoryObject{object}
+	delete(inv.objects, uuid)
+	if object.ObjectType == "storage" {
+		for _, snapshot := range inv.sortedObjects(func(o InventoryObject) bool {
+			return o.ObjectType == "snapshot" && o.Object.(StorageSnapshot).Properties.ParentUUID == uuid
+		}) {
+			deleted = append(deleted, snapshot)
+			delete(inv.objects, snapshot.ObjectUUID)
+		}
+	}
+	handlers := inv.handlers
+	inv.mu.Unlock()
+	for _, object := range deleted {
+		notifyDelete(handlers, object)
+	}
+}
+
+//replace replaces all objects of the given types
+func (inv *Inventory) replace(objects map[string]InventoryObject, objectTypes []string) {
+	inv.mu.Lock()
+	var added, deleted []InventoryObject
+	var updated [][2]InventoryObject
+	for _, old := range inv.sortedObjects(func(o InventoryObject) bool { return containsString(objectTypes, o.ObjectType) }) {
+		if _, ok := objects[old.ObjectUUID]; !ok {
+			deleted = append(deleted, old)
+			delete(inv.objects, old.ObjectUUID)
+		}
+	}
+	for _, object := range sortInventoryObjects(objects, nil) {
+		old, ok := inv.objects[object.ObjectUUID]
+		inv.objects[object.ObjectUUID] = object
+		if ok {
+			updated = append(updated, [2]InventoryObject{old, object})
+		} else {
+			added = append(added, object)
+		}
+	}
+	handlers := inv.handlers
+	inv.mu.Unlock()
+	for _, object := range deleted {
+		notifyDelete(handlers, object)
+	}
+	for _, object := range added {
+		notifyAdd(handlers, object)
+	}
+	for _, objects := range updated {
+		notifyUpdate(handlers, objects[0], objects[1])
+	}
+}
+
+//notifyAdd calls the OnAdd functions of handlers
+func notifyAdd(handlers []InventoryHandler, object InventoryObject) {
+	for _, handler := range handlers {
+		if handler.OnAdd != nil {
+			handler.OnAdd(object)
+		}
+	}
+}
+
+//notifyUpdate calls the OnUpdate functions of handlers if the object has changed
+func notifyUpdate(handlers []InventoryHandler, old, new InventoryObject) {
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+	for _, handler := range handlers {
+		if handler.OnUpdate != nil {
+			handler.OnUpdate(old, new)
+		}
+	}
+}
+
+//notifyDelete calls the OnDelete functions of handlers
+func notifyDelete(handlers []InventoryHandler, object InventoryObject) {
+	for _, handler := range handlers {
+		if handler.OnDelete != nil {
+			handler.OnDelete(object)
+		}
+	}
+}
+
+//Get returns the object with the given UUID
+func (inv *Inventory) Get(uuid string) (InventoryObject, bool) {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	object, ok := inv.objects[uuid]
+	return object, ok
+}
+
+//List returns all objects of a type (e.g. "server"), or all objects if the type is empty.
+//Objects are sorted by type and UUID.
+func (inv *Inventory) List(objectType string) []InventoryObject {
+	return inv.filter(func(o InventoryObject) bool {
+		return objectType == "" || o.ObjectType == objectType
+	})
+}
+
+//ByLabel returns all objects having a label, sorted by type and UUID
+func (inv *Inventory) ByLabel(label string) []InventoryObject {
+	return inv.filter(func(o InventoryObject) bool {
+		return containsString(o.Labels(), label)
+	})
+}
+
+//ByLocation returns all objects in a location, sorted by type and UUID
+func (inv *Inventory) ByLocation(locationUUID string) []InventoryObject {
+	return inv.filter(func(o InventoryObject) bool {
+		return o.LocationUUID() == locationUUID
+	})
+}
+
+//filter returns all objects matching a predicate, sorted by type and UUID
+func (inv *Inventory) filter(matches func(InventoryObject) bool) []InventoryObject {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	return inv.sortedObjects(matches)
+}
+
+//sortedObjects returns all objects matching a predicate, sorted by type and UUID. The caller has to hold mu.
+func (inv *Inventory) sortedObjects(matches func(InventoryObject) bool) []InventoryObject {
+	return sortInventoryObjects(inv.objects, matches)
+}
+
+//sortInventoryObjects returns the objects of a map matching a predicate (all if it is nil), sorted by type and UUID
+func sortInventoryObjects(objects map[string]InventoryObject, matches func(InventoryObject) bool) []InventoryObject {
+	var result []InventoryObject
+	for _, object := range objects {
+		if matches == nil || matches(object) {
+			result = append(result, object)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ObjectType != result[j].ObjectType {
+			return result[i].ObjectType < result[j].ObjectType
+		}
+		return result[i].ObjectUUID < result[j].ObjectUUID
+	})
+	return result
+}
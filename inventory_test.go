@@ -0,0 +1,221 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	inventoryServer1  = "00000000-0000-4000-8000-000000000001"
+	inventoryServer2  = "00000000-0000-4000-8000-000000000002"
+	inventoryStorage  = "00000000-0000-4000-8000-000000000003"
+	inventorySnapshot = "00000000-0000-4000-8000-000000000004"
+	inventoryNewSnap  = "00000000-0000-4000-8000-000000000005"
+)
+
+//inventoryAPI is a fake of the API serving servers, storages, snapshots and events
+type inventoryAPI struct {
+	mu        sync.Mutex
+	servers   map[string]ServerProperties
+	storages  map[string]StorageProperties
+	snapshots map[string]StorageSnapshotProperties
+	events    []EventProperties
+	requests  map[string]int
+}
+
+func (api *inventoryAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.requests[r.URL.Path]++
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var response interface{}
+	switch {
+	case r.URL.Path == apiEventBase:
+		response = EventList{List: api.events}
+	case r.URL.Path == apiServerBase:
+		response = ServerList{List: api.servers}
+	case r.URL.Path == apiStorageBase:
+		response = StorageList{List: api.storages}
+	case len(segments) == 3 && segments[1] == "servers":
+		if server, ok := api.servers[segments[2]]; ok {
+			response = Server{Properties: server}
+		}
+	case len(segments) == 3 && segments[1] == "storages":
+		if storage, ok := api.storages[segments[2]]; ok {
+			response = Storage{Properties: storage}
+		}
+	case len(segments) == 4 && segments[3] == "snapshots":
+		snapshots := make(map[string]StorageSnapshotProperties)
+		for id, snapshot := range api.snapshots {
+			if snapshot.ParentUUID == segments[2] {
+				snapshots[id] = snapshot
+			}
+		}
+		response = StorageSnapshotList{List: snapshots}
+	case len(segments) == 5 && segments[3] == "snapshots":
+		if snapshot, ok := api.snapshots[segments[4]]; ok && snapshot.ParentUUID == segments[2] {
+			response = StorageSnapshot{Properties: snapshot}
+		}
+	}
+	if response == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+//event adds an event about an object
+func (api *inventoryAPI) event(objectType, objectUUID string, timestamp time.Time) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.events = append(api.events, EventProperties{
+		ObjectType:  objectType,
+		ObjectUUID:  objectUUID,
+		RequestUUID: fmt.Sprintf("request-%d", len(api.events)),
+		Timestamp:   GSTime{timestamp},
+	})
+}
+
+func TestInventory(t *testing.T) {
+	api := &inventoryAPI{
+		servers: map[string]ServerProperties{
+			inventoryServer1: {ObjectUUID: inventoryServer1, Name: "web", Labels: []string{"web"}, LocationUUID: "fra"},
+			inventoryServer2: {ObjectUUID: inventoryServer2, Name: "db", Labels: []string{"db"}, LocationUUID: "ams"},
+		},
+		storages: map[string]StorageProperties{
+			inventoryStorage: {ObjectUUID: inventoryStorage, Name: "db", Labels: []string{"db"}, LocationUUID: "ams"},
+		},
+		snapshots: map[string]StorageSnapshotProperties{
+			inventorySnapshot: {ObjectUUID: inventorySnapshot, ParentUUID: inventoryStorage, LocationUUID: "ams"},
+		},
+		requests: make(map[string]int),
+	}
+	start := dummyTime.Time
+	api.event("server", inventoryServer1, start)
+	server := httptest.NewServer(api)
+	defer server.Close()
+	client := NewClient(NewConfig(WithAPIURL(server.URL)))
+	inventory := NewInventory(client, InventoryOptions{ObjectTypes: []string{"server", "storage", "snapshot"}})
+	var changes []string
+	inventory.AddHandler(InventoryHandler{
+		OnAdd: func(object InventoryObject) {
+			changes = append(changes, "add "+object.ObjectUUID)
+		},
+		OnUpdate: func(old, new InventoryObject) {
+			changes = append(changes, "update "+new.ObjectUUID)
+		},
+		OnDelete: func(object InventoryObject) {
+			changes = append(changes, "delete "+object.ObjectUUID)
+		},
+	})
+	uuids := func(objects []InventoryObject) []string {
+		var result []string
+		for _, object := range objects {
+			result = append(result, object.ObjectUUID)
+		}
+		return result
+	}
+
+	assert.False(t, inventory.HasSynced())
+	assert.Nil(t, inventory.Sync(emptyCtx))
+	assert.True(t, inventory.HasSynced())
+	assert.Equal(t, []string{"add " + inventoryServer1, "add " + inventoryServer2, "add " + inventorySnapshot,
+		"add " + inventoryStorage}, changes)
+	assert.Equal(t, []string{inventoryServer1, inventoryServer2}, uuids(inventory.List("server")))
+	assert.Equal(t, []string{inventoryServer2, inventorySnapshot, inventoryStorage}, uuids(inventory.ByLocation("ams")))
+	assert.Equal(t, []string{inventoryServer2, inventoryStorage}, uuids(inventory.ByLabel("db")))
+	object, ok := inventory.Get(inventoryServer1)
+	if assert.True(t, ok) {
+		assert.Equal(t, "server", object.ObjectType)
+		assert.Equal(t, "web", object.Object.(Server).Properties.Name)
+		assert.Equal(t, []string{"web"}, object.Labels())
+		assert.Equal(t, "fra", object.LocationUUID())
+	}
+
+	//the events of the sync are not processed again
+	changes = nil
+	assert.Nil(t, inventory.Poll(emptyCtx))
+	assert.Empty(t, changes)
+	assert.Equal(t, 0, api.requests[apiServerBase+"/"+inventoryServer1])
+
+	//only the objects named in new events are read again
+	api.mu.Lock()
+	server1 := api.servers[inventoryServer1]
+	server1.Name = "web-1"
+	api.servers[inventoryServer1] = server1
+	delete(api.storages, inventoryStorage)
+	delete(api.snapshots, inventorySnapshot)
+	api.mu.Unlock()
+	api.event("Server", inventoryServer1, start.Add(time.Second))
+	api.event("Storage", inventoryStorage, start.Add(time.Second))
+	api.event("server", inventoryServer2, start.Add(time.Second))
+	assert.Nil(t, inventory.Poll(emptyCtx))
+	assert.Equal(t, []string{"update " + inventoryServer1, "delete " + inventoryStorage, "delete " + inventorySnapshot}, changes)
+	assert.Equal(t, 1, api.requests[apiServerBase+"/"+inventoryServer1])
+	assert.Equal(t, 1, api.requests[apiServerBase+"/"+inventoryServer2])
+	assert.Equal(t, 1, api.requests[apiServerBase])
+	object, _ = inventory.Get(inventoryServer1)
+	assert.Equal(t, "web-1", object.Object.(Server).Properties.Name)
+	assert.Empty(t, inventory.List("snapshot"))
+
+	//snapshots which are not known yet are found by listing the snapshots of all storages
+	changes = nil
+	api.mu.Lock()
+	api.storages[inventoryStorage] = StorageProperties{ObjectUUID: inventoryStorage}
+	api.snapshots[inventoryNewSnap] = StorageSnapshotProperties{ObjectUUID: inventoryNewSnap, ParentUUID: inventoryStorage}
+	api.mu.Unlock()
+	api.event("storage", inventoryStorage, start.Add(2*time.Second))
+	api.event("snapshot", inventoryNewSnap, start.Add(2*time.Second))
+	api.event("firewall", dummyUUID, start.Add(2*time.Second))
+	assert.Nil(t, inventory.Poll(emptyCtx))
+	assert.Equal(t, []string{"add " + inventoryStorage, "add " + inventoryNewSnap}, changes)
+	assert.Equal(t, []string{inventoryServer1, inventoryServer2, inventoryNewSnap, inventoryStorage}, uuids(inventory.List("")))
+}
+
+func TestInventory_Errors(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	var fail bool
+	mux.HandleFunc(apiEventBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"events": [{"object_type": "server", "object_uuid": "%s", "request_uuid": "a", "timestamp": "%s"}]}`,
+			dummyUUID, dummyTime.Format(gsTimeLayout))
+	})
+	mux.HandleFunc(apiServerBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"servers": {}}`)
+	})
+	var requests int
+	mux.HandleFunc(apiServerBase+"/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if fail {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"server": {"object_uuid": "%s"}}`, dummyUUID)
+	})
+
+	inventory := NewInventory(client, InventoryOptions{ObjectTypes: []string{"server", "template"}})
+	err := inventory.Sync(emptyCtx)
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+
+	//the events are processed again if reading an object has failed
+	inventory = NewInventory(client, InventoryOptions{ObjectTypes: []string{"server"}})
+	inventory.cursor.last = GSTime{dummyTime.Add(-time.Second)}
+	inventory.synced = true
+	fail = true
+	assert.NotNil(t, inventory.Poll(emptyCtx))
+	fail = false
+	assert.Nil(t, inventory.Poll(emptyCtx))
+	assert.Nil(t, inventory.Poll(emptyCtx))
+	assert.Equal(t, 2, requests)
+	_, ok := inventory.Get(dummyUUID)
+	assert.True(t, ok)
+}
@@ -1,11 +1,27 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//IPOperator is an interface defining API of an IP operator
+type IPOperator interface {
+	GetIP(ctx context.Context, id string) (IP, error)
+	GetIPList(ctx context.Context, opts ...ListOptions) ([]IP, error)
+	IPsIter(ctx context.Context, yield func(IP) bool) error
+	CreateIP(ctx context.Context, body IPCreateRequest) (IPCreateResponse, *Operation, error)
+	DeleteIP(ctx context.Context, id string) (*Operation, error)
+	UpdateIP(ctx context.Context, id string, body IPUpdateRequest) (*Operation, error)
+	UpdateIPFunc(ctx context.Context, id string, mutate func(*IPProperties) error) (*Operation, error)
+	GetIPEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+	GetIPVersion(ctx context.Context, id string) int
+	GetIPsByLocation(ctx context.Context, id string, opts ...ListOptions) ([]IP, error)
+	GetDeletedIPs(ctx context.Context, opts ...ListOptions) ([]IP, error)
+}
+
 //IPList is JSON struct of a list of IPs
 type IPList struct {
 	//Array of IP addresses
@@ -159,19 +175,42 @@ type IPCreateRequest struct {
 	Labels []string `json:"labels,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r IPCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, true)
+	if r.Family != IPv4Type && r.Family != IPv6Type {
+		v.add("family", "has to be IPv4Type or IPv6Type")
+	}
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //IPUpdateRequest is JSON struct of a request for updating an IP
 type IPUpdateRequest struct {
 	//New name. Leave it if you do not want to update the name
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//Sets failover mode for this IP. If true, then this IP is no longer available for DHCP and can no longer be related to any server.
-	Failover bool `json:"failover"`
+	Failover *bool `json:"failover,omitempty"`
 
 	//Defines the reverse DNS entry for the IP Address (PTR Resource Record). Leave it if you do not want to update the reverse DNS.
-	ReverseDNS string `json:"reverse_dns,omitempty"`
+	ReverseDNS *string `json:"reverse_dns,omitempty"`
 
 	//List of labels. Leave it if you do not want to update the labels.
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r IPUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
 }
 
 //Allowed IP address versions
@@ -183,9 +222,9 @@ var (
 //GetIP get a specific IP based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getIp
-func (c *Client) GetIP(id string) (IP, error) {
+func (c *Client) GetIP(ctx context.Context, id string) (IP, error) {
 	if !isValidUUID(id) {
-		return IP{}, errors.New("'id' is invalid")
+		return IP{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiIPBase, id),
@@ -193,7 +232,7 @@ func (c *Client) GetIP(id string) (IP, error) {
 	}
 
 	var response IP
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 
 	return response, err
 }
@@ -201,20 +240,45 @@ func (c *Client) GetIP(id string) (IP, error) {
 //GetIPList gets a list of available IPs
 //
 //https://gridscale.io/en//api-documentation/index.html#operation/getIps
-func (c *Client) GetIPList() ([]IP, error) {
+func (c *Client) GetIPList(ctx context.Context, opts ...ListOptions) ([]IP, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiIPBase,
+		uri:    apiIPBase + options.query(),
 		method: http.MethodGet,
 	}
 
 	var response IPList
 	var IPs []IP
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		IPs = append(IPs, IP{Properties: properties})
 	}
+	return IPs, options.apply(&IPs)
+}
 
-	return IPs, err
+//IPsIter calls yield for all IP addresses, in the order of the response. The response is decoded one object at a
+//time instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) IPsIter(ctx context.Context, yield func(IP) bool) error {
+	return c.iterate(ctx, apiIPBase, "ips", func(key string, dec *json.Decoder) (bool, error) {
+		var ip IP
+		if err := dec.Decode(&ip.Properties); err != nil {
+			return false, err
+		}
+		if ip.Properties.ObjectUUID == "" {
+			ip.Properties.ObjectUUID = key
+		}
+		return yield(ip), nil
+	})
 }
 
 //CreateIP creates an IP
@@ -222,7 +286,10 @@ func (c *Client) GetIPList() ([]IP, error) {
 //Note: IP address family can only be either `IPv4Type` or `IPv6Type`
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createIp
-func (c *Client) CreateIP(body IPCreateRequest) (IPCreateResponse, error) {
+func (c *Client) CreateIP(ctx context.Context, body IPCreateRequest) (IPCreateResponse, *Operation, error) {
+	if body.LocationUUID == "" {
+		body.LocationUUID = c.cfg.locationUUID
+	}
 	r := Request{
 		uri:    apiIPBase,
 		method: http.MethodPost,
@@ -230,84 +297,109 @@ func (c *Client) CreateIP(body IPCreateRequest) (IPCreateResponse, error) {
 	}
 
 	var response IPCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return IPCreateResponse{}, err
+		return IPCreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "ip", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //DeleteIP deletes a specific IP based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteIp
-func (c *Client) DeleteIP(id string) error {
+func (c *Client) DeleteIP(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiIPBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForIPDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "ip", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForIPDeleted(ctx, id)
+	})
 }
 
 //UpdateIP updates a specific IP based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateIp
-func (c *Client) UpdateIP(id string, body IPUpdateRequest) error {
+func (c *Client) UpdateIP(ctx context.Context, id string, body IPUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiIPBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForIPActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "ip", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForIPActive(ctx, id)
+	})
+}
+
+//UpdateIPFunc updates an IP address by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateIPFunc(ctx context.Context, id string, mutate func(*IPProperties) error) (*Operation, error) {
+	var body IPUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "ip",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetIP(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*IPProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateIP(ctx, id, body)
+		},
+	})
 }
 
 //GetIPEventList gets a list of an IP's events
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getIpEvents
-func (c *Client) GetIPEventList(id string) ([]Event, error) {
+func (c *Client) GetIPEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiIPBase, id, "events"),
+		uri:    path.Join(apiIPBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var IPEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		IPEvents = append(IPEvents, Event{Properties: properties})
 	}
-	return IPEvents, err
+	return IPEvents, options.apply(&IPEvents)
 }
 
 //GetIPVersion gets IP's version, returns 0 if an error was encountered
-func (c *Client) GetIPVersion(id string) int {
-	ip, err := c.GetIP(id)
+func (c *Client) GetIPVersion(ctx context.Context, id string) int {
+	ip, err := c.GetIP(ctx, id)
 	if err != nil {
 		return 0
 	}
@@ -317,54 +409,73 @@ func (c *Client) GetIPVersion(id string) int {
 //GetIPsByLocation gets a list of IPs by location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocationIps
-func (c *Client) GetIPsByLocation(id string) ([]IP, error) {
+func (c *Client) GetIPsByLocation(ctx context.Context, id string, opts ...ListOptions) ([]IP, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLocationBase, id, "ips"),
+		uri:    path.Join(apiLocationBase, id, "ips") + options.query(),
 		method: http.MethodGet,
 	}
 	var response IPList
 	var IPs []IP
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		IPs = append(IPs, IP{Properties: properties})
 	}
-	return IPs, err
+	return IPs, options.apply(&IPs)
 }
 
 //GetDeletedIPs gets a list of deleted IPs
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedIps
-func (c *Client) GetDeletedIPs() ([]IP, error) {
+func (c *Client) GetDeletedIPs(ctx context.Context, opts ...ListOptions) ([]IP, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "ips"),
+		uri:    path.Join(apiDeletedBase, "ips") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedIPList
 	var IPs []IP
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		IPs = append(IPs, IP{Properties: properties})
 	}
-	return IPs, err
+	return IPs, options.apply(&IPs)
 }
 
 //waitForIPActive allows to wait until the IP address's status is active
-func (c *Client) waitForIPActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		ip, err := c.GetIP(id)
-		return ip.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForIPActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetIP(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForIPDeleted allows to wait until the IP address is deleted
-func (c *Client) waitForIPDeleted(id string) error {
+func (c *Client) waitForIPDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiIPBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"net/http"
 	"path"
+	"strings"
 	"testing"
 )
 
@@ -17,7 +19,7 @@ func TestClient_GetIPList(t *testing.T) {
 		assert.Equal(t, http.MethodGet, request.Method)
 		fmt.Fprintf(writer, prepareIPListHTTPGet("active"))
 	})
-	res, err := client.GetIPList()
+	res, err := client.GetIPList(emptyCtx)
 	assert.Nil(t, err, "GetIPList returned an error %v", err)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockIP("active")), fmt.Sprintf("%v", res))
@@ -32,7 +34,7 @@ func TestClient_GetIP(t *testing.T) {
 		fmt.Fprintf(writer, prepareIPHTTPGet("active"))
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetIP(test.testUUID)
+		res, err := client.GetIP(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -63,7 +65,7 @@ func TestClient_CreateIP(t *testing.T) {
 		}
 		for _, test := range commonSuccessFailTestCases {
 			isFailed = test.isFailed
-			response, err := client.CreateIP(IPCreateRequest{
+			response, _, err := client.CreateIP(emptyCtx, IPCreateRequest{
 				Name:         "test",
 				Family:       IPv4Type,
 				LocationUUID: dummyUUID,
@@ -100,10 +102,10 @@ func TestClient_UpdateIP(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, test := range uuidCommonTestCases {
-				err := client.UpdateIP(test.testUUID, IPUpdateRequest{
-					Name:       "test",
-					Failover:   false,
-					ReverseDNS: "8.8.4.4",
+				_, err := client.UpdateIP(emptyCtx, test.testUUID, IPUpdateRequest{
+					Name:       String("test"),
+					Failover:   Bool(false),
+					ReverseDNS: String("8.8.4.4"),
 				})
 				if test.isFailed || isFailed {
 					assert.NotNil(t, err)
@@ -116,6 +118,20 @@ func TestClient_UpdateIP(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateIP_OnlySetFields(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	var body string
+	mux.HandleFunc(path.Join(apiIPBase, dummyUUID), func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = strings.TrimSpace(string(data))
+	})
+	//renaming an IP does not change its failover mode
+	_, err := client.UpdateIP(emptyCtx, dummyUUID, IPUpdateRequest{Name: String("renamed")})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"renamed"}`, body)
+}
+
 func TestClient_DeleteIP(t *testing.T) {
 	for _, clientTest := range syncClientTestCases {
 		server, client, mux := setupTestClient(clientTest)
@@ -135,7 +151,7 @@ func TestClient_DeleteIP(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, test := range uuidCommonTestCases {
-				err := client.DeleteIP(test.testUUID)
+				_, err := client.DeleteIP(emptyCtx, test.testUUID)
 				if test.isFailed || isFailed {
 					assert.NotNil(t, err)
 				} else {
@@ -156,7 +172,7 @@ func TestClient_GetIPEventList(t *testing.T) {
 		fmt.Fprintf(writer, prepareEventListHTTPGet())
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetIPEventList(test.testUUID)
+		res, err := client.GetIPEventList(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -183,7 +199,7 @@ func TestClient_GetIPVersion(t *testing.T) {
 	})
 	for _, test := range commonSuccessFailTestCases {
 		isFailed = test.isFailed
-		res := client.GetIPVersion(dummyUUID)
+		res := client.GetIPVersion(emptyCtx, dummyUUID)
 		if test.isFailed {
 			assert.Equal(t, 0, res)
 		} else {
@@ -201,7 +217,7 @@ func TestClient_GetIPsByLocation(t *testing.T) {
 		fmt.Fprintf(writer, prepareIPListHTTPGet("active"))
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetIPsByLocation(test.testUUID)
+		res, err := client.GetIPsByLocation(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -220,7 +236,7 @@ func TestClient_GetDeletedIPs(t *testing.T) {
 		assert.Equal(t, http.MethodGet, request.Method)
 		fmt.Fprintf(writer, prepareDeletedIPListHTTPGet("deleted"))
 	})
-	res, err := client.GetDeletedIPs()
+	res, err := client.GetDeletedIPs(emptyCtx)
 	assert.Nil(t, err, "GetDeletedIPs returned an error %v", err)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockIP("deleted")), fmt.Sprintf("%v", res))
@@ -234,7 +250,7 @@ func TestClient_waitForIPActive(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		fmt.Fprint(w, prepareIPHTTPGet("active"))
 	})
-	err := client.waitForIPActive(dummyUUID)
+	err := client.waitForIPActive(emptyCtx, dummyUUID)
 	assert.Nil(t, err, "waitForIPActive returned an error %v", err)
 }
 
@@ -247,7 +263,7 @@ func TestClient_waitForIPDeleted(t *testing.T) {
 		w.WriteHeader(404)
 	})
 	for _, test := range uuidCommonTestCases {
-		err := client.waitForIPDeleted(test.testUUID)
+		err := client.waitForIPDeleted(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
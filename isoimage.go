@@ -1,11 +1,26 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//ISOImageOperator is an interface defining API of an ISO-image operator
+type ISOImageOperator interface {
+	GetISOImageList(ctx context.Context, opts ...ListOptions) ([]ISOImage, error)
+	ISOImagesIter(ctx context.Context, yield func(ISOImage) bool) error
+	GetISOImage(ctx context.Context, id string) (ISOImage, error)
+	CreateISOImage(ctx context.Context, body ISOImageCreateRequest) (ISOImageCreateResponse, *Operation, error)
+	UpdateISOImage(ctx context.Context, id string, body ISOImageUpdateRequest) (*Operation, error)
+	UpdateISOImageFunc(ctx context.Context, id string, mutate func(*ISOImageProperties) error) (*Operation, error)
+	DeleteISOImage(ctx context.Context, id string) (*Operation, error)
+	GetISOImageEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+	GetISOImagesByLocation(ctx context.Context, id string, opts ...ListOptions) ([]ISOImage, error)
+	GetDeletedISOImages(ctx context.Context, opts ...ListOptions) ([]ISOImage, error)
+}
+
 //ISOImageList is JSON struct of a list of ISO images
 type ISOImageList struct {
 	//List of ISO-images
@@ -117,6 +132,15 @@ type ISOImageCreateRequest struct {
 	LocationUUID string `json:"location_uuid"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r ISOImageCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.required("source_url", r.SourceURL)
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //ISOImageCreateResponse is JSON struct of a response for creating an ISO-Image
 type ISOImageCreateResponse struct {
 	//Request's UUID
@@ -129,181 +153,266 @@ type ISOImageCreateResponse struct {
 //ISOImageUpdateRequest is JSON struct of a request for updating an ISO-Image
 type ISOImageUpdateRequest struct {
 	//New name. Leave it if you do not want to update the name.
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//List of labels. Leave it if you do not want to update the list of labels.
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r ISOImageUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
 }
 
 //GetISOImageList returns a list of available ISO images
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getIsoimages
-func (c *Client) GetISOImageList() ([]ISOImage, error) {
+func (c *Client) GetISOImageList(ctx context.Context, opts ...ListOptions) ([]ISOImage, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiISOBase),
+		uri:    path.Join(apiISOBase) + options.query(),
 		method: http.MethodGet,
 	}
 	var response ISOImageList
 	var isoImages []ISOImage
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		isoImages = append(isoImages, ISOImage{Properties: properties})
 	}
-	return isoImages, err
+	return isoImages, options.apply(&isoImages)
+}
+
+//ISOImagesIter calls yield for all ISO images, in the order of the response. The response is decoded one object at a
+//time instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) ISOImagesIter(ctx context.Context, yield func(ISOImage) bool) error {
+	return c.iterate(ctx, apiISOBase, "isoimages", func(key string, dec *json.Decoder) (bool, error) {
+		var isoImage ISOImage
+		if err := dec.Decode(&isoImage.Properties); err != nil {
+			return false, err
+		}
+		if isoImage.Properties.ObjectUUID == "" {
+			isoImage.Properties.ObjectUUID = key
+		}
+		return yield(isoImage), nil
+	})
 }
 
 //GetISOImage returns a specific ISO image based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getIsoimage
-func (c *Client) GetISOImage(id string) (ISOImage, error) {
+func (c *Client) GetISOImage(ctx context.Context, id string) (ISOImage, error) {
 	if !isValidUUID(id) {
-		return ISOImage{}, errors.New("'id' is invalid")
+		return ISOImage{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiISOBase, id),
 		method: http.MethodGet,
 	}
 	var response ISOImage
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //CreateISOImage creates an ISO image
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createIsoimage
-func (c *Client) CreateISOImage(body ISOImageCreateRequest) (ISOImageCreateResponse, error) {
+func (c *Client) CreateISOImage(ctx context.Context, body ISOImageCreateRequest) (ISOImageCreateResponse, *Operation, error) {
+	if body.LocationUUID == "" {
+		body.LocationUUID = c.cfg.locationUUID
+	}
 	r := Request{
 		uri:    path.Join(apiISOBase),
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response ISOImageCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return ISOImageCreateResponse{}, err
+		return ISOImageCreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "isoimage", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //UpdateISOImage updates a specific ISO Image
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateIsoimage
-func (c *Client) UpdateISOImage(id string, body ISOImageUpdateRequest) error {
+func (c *Client) UpdateISOImage(ctx context.Context, id string, body ISOImageUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiISOBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForISOImageActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "isoimage", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForISOImageActive(ctx, id)
+	})
+}
+
+//UpdateISOImageFunc updates an ISO image by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateISOImageFunc(ctx context.Context, id string, mutate func(*ISOImageProperties) error) (*Operation, error) {
+	var body ISOImageUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "isoimage",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetISOImage(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*ISOImageProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateISOImage(ctx, id, body)
+		},
+	})
 }
 
 //DeleteISOImage deletes a specific ISO image
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteIsoimage
-func (c *Client) DeleteISOImage(id string) error {
+func (c *Client) DeleteISOImage(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiISOBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForISOImageDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "isoimage", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForISOImageDeleted(ctx, id)
+	})
 }
 
 //GetISOImageEventList returns a list of events of an ISO image
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getIsoimageEvents
-func (c *Client) GetISOImageEventList(id string) ([]Event, error) {
+func (c *Client) GetISOImageEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiISOBase, id, "events"),
+		uri:    path.Join(apiISOBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var isoImageEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		isoImageEvents = append(isoImageEvents, Event{Properties: properties})
 	}
-	return isoImageEvents, err
+	return isoImageEvents, options.apply(&isoImageEvents)
 }
 
 //GetISOImagesByLocation gets a list of ISO images by location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocationIsoimages
-func (c *Client) GetISOImagesByLocation(id string) ([]ISOImage, error) {
+func (c *Client) GetISOImagesByLocation(ctx context.Context, id string, opts ...ListOptions) ([]ISOImage, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLocationBase, id, "isoimages"),
+		uri:    path.Join(apiLocationBase, id, "isoimages") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ISOImageList
 	var isoImages []ISOImage
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		isoImages = append(isoImages, ISOImage{Properties: properties})
 	}
-	return isoImages, err
+	return isoImages, options.apply(&isoImages)
 }
 
 //GetDeletedISOImages gets a list of deleted ISO images
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedIsoimages
-func (c *Client) GetDeletedISOImages() ([]ISOImage, error) {
+func (c *Client) GetDeletedISOImages(ctx context.Context, opts ...ListOptions) ([]ISOImage, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "isoimages"),
+		uri:    path.Join(apiDeletedBase, "isoimages") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedISOImageList
 	var isoImages []ISOImage
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		isoImages = append(isoImages, ISOImage{Properties: properties})
 	}
-	return isoImages, err
+	return isoImages, options.apply(&isoImages)
 }
 
 //waitForISOImageActive allows to wait until the ISO-Image's status is active
-func (c *Client) waitForISOImageActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		img, err := c.GetISOImage(id)
-		return img.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForISOImageActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetISOImage(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForISOImageDeleted allows to wait until the ISO-Image id deleted
-func (c *Client) waitForISOImageDeleted(id string) error {
+func (c *Client) waitForISOImageDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiISOBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
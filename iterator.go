@@ -0,0 +1,90 @@
+package gsclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+//iterate sends a GET request and decodes the list in the given member of the response item by item, without
+//reading the whole response into memory. The list can be a JSON object (keyed by UUID) or an array.
+//
+//next is called for every item with its key (empty in arrays) and a decoder positioned at the item, which next has
+//to decode. Iterating stops when next returns false or an error, or when the context is done.
+func (c *Client) iterate(ctx context.Context, uri, member string, next func(key string, dec *json.Decoder) (bool, error)) error {
+	//cancelling the context aborts reading the rest of the body when iterating stops early
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	r := Request{
+		uri:    uri,
+		method: http.MethodGet,
+	}
+	body, err := r.stream(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	dec := json.NewDecoder(body)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if token != member {
+			//skip everything else in the response
+			var skipped json.RawMessage
+			if err := dec.Decode(&skipped); err != nil {
+				return err
+			}
+			continue
+		}
+		token, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			continue
+		}
+		keyed := token == json.Delim('{')
+		if !keyed && token != json.Delim('[') {
+			return fmt.Errorf("%s in response of %s is not a list", member, uri)
+		}
+		for dec.More() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var key string
+			if keyed {
+				token, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, _ = token.(string)
+			}
+			ok, err := next(key, dec)
+			if err != nil || !ok {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//expectDelim reads the next token of a decoder, which has to be the given delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("unexpected %v in response, expected %v", token, delim)
+	}
+	return nil
+}
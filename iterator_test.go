@@ -0,0 +1,178 @@
+package gsclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ServersIter(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	mux.HandleFunc(apiServerBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"meta": {"total": 3}, "servers": {
+			"a": {"object_uuid": "a", "name": "first"},
+			"b": {"name": "second", "labels": ["x"]},
+			"c": {"object_uuid": "c", "name": "third"}
+		}, "other": [1, 2]}`)
+	})
+
+	var names, uuids []string
+	err := client.ServersIter(emptyCtx, func(server Server) bool {
+		names = append(names, server.Properties.Name)
+		uuids = append(uuids, server.Properties.ObjectUUID)
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first", "second", "third"}, names)
+	assert.Equal(t, []string{"a", "b", "c"}, uuids)
+
+	//stops early when yield returns false
+	var count int
+	err = client.ServersIter(emptyCtx, func(server Server) bool {
+		count++
+		return false
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	//stops when the context is done
+	ctx, cancel := context.WithCancel(emptyCtx)
+	count = 0
+	err = client.ServersIter(ctx, func(server Server) bool {
+		count++
+		cancel()
+		return true
+	})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 1, count)
+}
+
+func TestClient_EventsIter(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	mux.HandleFunc(apiEventBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, prepareEventListHTTPGet())
+	})
+	var events []Event
+	err := client.EventsIter(emptyCtx, func(event Event) bool {
+		events = append(events, event)
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []Event{getMockEvent()}, events)
+}
+
+func TestClient_StoragesIter_Errors(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	var response string
+	var status int
+	mux.HandleFunc(apiStorageBase, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, response)
+	})
+	yield := func(storage Storage) bool { return true }
+	testCases := []struct {
+		status   int
+		response string
+		err      error
+	}{
+		{http.StatusOK, `{"storages": {}}`, nil},
+		{http.StatusOK, `{"storages": null}`, nil},
+		{http.StatusNotFound, `{"title": "Not Found"}`, ErrNotFound},
+		{http.StatusOK, `{"storages": {"a": {"name": `, errors.New("unexpected EOF")},
+		{http.StatusOK, `{"storages": "none"}`, errors.New("storages in response of /objects/storages is not a list")},
+		{http.StatusOK, `[]`, errors.New("unexpected [ in response, expected {")},
+	}
+	for _, test := range testCases {
+		status, response = test.status, test.response
+		err := client.StoragesIter(emptyCtx, yield)
+		switch {
+		case test.err == nil:
+			assert.Nil(t, err)
+		case errors.Is(test.err, ErrNotFound):
+			assert.True(t, errors.Is(err, ErrNotFound))
+		default:
+			if assert.NotNil(t, err) {
+				assert.Equal(t, test.err.Error(), err.Error())
+			}
+		}
+	}
+}
+
+func TestClient_NetworksIter_Middleware(t *testing.T) {
+	//the response of a middleware which answers the request itself is decoded as well
+	client := NewClient(NewConfig(WithAPIURL("http://localhost"), WithMiddleware(func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+			return &MiddlewareResponse{StatusCode: http.StatusOK, Body: []byte(`{"networks": {"a": {"name": "network"}}}`)}
+		}
+	})))
+	var names []string
+	err := client.NetworksIter(emptyCtx, func(network Network) bool {
+		names = append(names, network.Properties.Name)
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"network"}, names)
+}
+
+//eventListServer serves a list of many events
+func eventListServer() (*httptest.Server, *Client) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"events": [`)
+	event, _ := json.Marshal(getMockEvent().Properties)
+	for i := 0; i < 10000; i++ {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.Write(event)
+	}
+	buf.WriteString(`]}`)
+	body := buf.Bytes()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	return server, NewClient(NewConfig(WithAPIURL(server.URL), WithLogger(NoopLogger{})))
+}
+
+func BenchmarkClient_GetEventList(b *testing.B) {
+	server, client := eventListServer()
+	defer server.Close()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var count int
+		events, err := client.GetEventList(emptyCtx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for range events {
+			count++
+		}
+	}
+}
+
+func BenchmarkClient_EventsIter(b *testing.B) {
+	server, client := eventListServer()
+	defer server.Close()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var count int
+		err := client.EventsIter(emptyCtx, func(event Event) bool {
+			count++
+			return true
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
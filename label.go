@@ -1,11 +1,18 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 )
 
+//LabelOperator is an interface defining API of a label operator
+type LabelOperator interface {
+	GetLabelList(ctx context.Context, opts ...ListOptions) ([]Label, error)
+	CreateLabel(ctx context.Context, body LabelCreateRequest) (CreateResponse, *Operation, error)
+	DeleteLabel(ctx context.Context, label string) (*Operation, error)
+}
+
 //LabelList JSON struct of a list of labels
 type LabelList struct {
 	//List of labels
@@ -42,73 +49,94 @@ type LabelCreateRequest struct {
 	Label string `json:"label"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r LabelCreateRequest) Validate() error {
+	var v validator
+	v.required("label", r.Label)
+	return v.err()
+}
+
 //GetLabelList gets a list of available labels
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/GetLabels
-func (c *Client) GetLabelList() ([]Label, error) {
+func (c *Client) GetLabelList(ctx context.Context, opts ...ListOptions) ([]Label, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiLabelBase,
+		uri:    apiLabelBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response LabelList
 	var labels []Label
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.Label == "" {
+			properties.Label = key
+		}
 		labels = append(labels, Label{Properties: properties})
 	}
-	return labels, err
+	return labels, options.apply(&labels)
 }
 
 //CreateLabel creates a new label
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/CreateLabel
-func (c *Client) CreateLabel(body LabelCreateRequest) (CreateResponse, error) {
+func (c *Client) CreateLabel(ctx context.Context, body LabelCreateRequest) (CreateResponse, *Operation, error) {
 	r := Request{
 		uri:    apiLabelBase,
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response CreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return CreateResponse{}, err
+		return CreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "label", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //DeleteLabel deletes a label
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/DeleteLabel
-func (c *Client) DeleteLabel(label string) error {
+func (c *Client) DeleteLabel(ctx context.Context, label string) (*Operation, error) {
 	if label == "" {
-		return errors.New("'label' is required")
+		return nil, newArgumentError("'label' is required", "label")
 	}
 	r := Request{
 		uri:    path.Join(apiLabelBase, label),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForLabelDeleted(label)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "label", label, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForLabelDeleted(ctx, label)
+	})
 }
 
 //waitForLabelDeleted allows to wait until the label is deleted
-func (c *Client) waitForLabelDeleted(label string) error {
+func (c *Client) waitForLabelDeleted(ctx context.Context, label string) error {
 	if label == "" {
-		return errors.New("'label' is required")
+		return newArgumentError("'label' is required", "label")
 	}
-	return retryWithTimeout(func() (bool, error) {
-		labels, err := c.GetLabelList()
-		return isLabelInSlice(label, labels), err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetLabelList(ctx)
+	}, func(object interface{}, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+		labels, _ := object.([]Label)
+		return !isLabelInSlice(label, labels), nil
+	}, c.waitOptions())
 }
 
 //isLabelInSlice check if a label in a lice of labels
@@ -28,7 +28,7 @@ func TestClient_GetLabelList(t *testing.T) {
 		assert.Equal(t, http.MethodGet, request.Method)
 		fmt.Fprintf(writer, prepareLabelListHTTPGet("test"))
 	})
-	res, err := client.GetLabelList()
+	res, err := client.GetLabelList(emptyCtx)
 	assert.Nil(t, err, "GetLabelList returned an error %v", err)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockLabel("test")), fmt.Sprintf("%v", res))
@@ -52,7 +52,7 @@ func TestClient_CreateLabel(t *testing.T) {
 	})
 	for _, test := range commonSuccessFailTestCases {
 		isFailed = test.isFailed
-		res, err := client.CreateLabel(LabelCreateRequest{Label: "test"})
+		res, _, err := client.CreateLabel(emptyCtx, LabelCreateRequest{Label: "test"})
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -72,7 +72,7 @@ func TestClient_waitForLabelDeleted(t *testing.T) {
 		fmt.Fprint(w, prepareLabelListHTTPGet("not-test"))
 	})
 	for _, test := range labelTestCases {
-		err := client.waitForLabelDeleted(test.testUUID)
+		err := client.waitForLabelDeleted(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -103,7 +103,7 @@ func TestClient_DeleteLabel(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, test := range labelTestCases {
-				err := client.DeleteLabel(test.testUUID)
+				_, err := client.DeleteLabel(emptyCtx, test.testUUID)
 				if test.isFailed || isFailed {
 					assert.NotNil(t, err)
 				} else {
@@ -0,0 +1,310 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//SortKey is a property lists are sorted by
+type SortKey struct {
+	//JSON name of the property, e.g. "name", "create_time" or "capacity"
+	Property string
+
+	//Descending sorts the largest values first
+	Descending bool
+}
+
+//defaultSortKeys is the order of all lists of objects returned by the client: the oldest objects first,
+//objects created at the same time by their UUID (labels have no UUID, they are sorted by their name instead).
+//Events have no creation time, they are sorted by their timestamp.
+var defaultSortKeys = []SortKey{
+	{Property: "timestamp"},
+	{Property: "create_time"},
+	{Property: "object_uuid"},
+	{Property: "label"},
+}
+
+//SortList sorts a list returned by the client (e.g. []Server or []ServerStorageRelationProperties) by the given keys.
+//Objects which are equal in all keys keep their order. Properties can be strings, numbers, booleans and times.
+//
+//Lists of objects are sorted by creation time (events by timestamp) and UUID when they are returned, SortList can be
+//used for any other order:
+//
+//	servers, err := client.GetServerList(ctx)
+//	err = gsclient.SortList(servers, gsclient.SortKey{Property: "name"})
+func SortList(list interface{}, keys ...SortKey) error {
+	return sortList(list, keys, true)
+}
+
+//sortByDefault sorts a list returned by the client by defaultSortKeys
+func sortByDefault(list interface{}) {
+	sortList(list, defaultSortKeys, false)
+}
+
+//sortList sorts a slice of objects by the properties of the given keys. If strict is not set, keys which are not
+//properties of the objects are ignored instead of being an ArgumentError.
+func sortList(list interface{}, keys []SortKey, strict bool) error {
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Struct {
+		return newArgumentError(fmt.Sprintf("a list of objects is required, not %T", list), "list")
+	}
+	propertiesIndex, propertiesType := propertiesOf(v.Type().Elem())
+	type field struct {
+		index      []int
+		descending bool
+	}
+	var fields []field
+	for _, key := range keys {
+		index, ok := fieldIndex(propertiesType, key.Property)
+		if !ok || !isSortable(propertiesType.FieldByIndex(index).Type) {
+			if !strict {
+				continue
+			}
+			return newArgumentError(fmt.Sprintf("%s cannot be sorted by %s", v.Type(), key.Property), "keys")
+		}
+		fields = append(fields, field{
+			index:      append(append([]int{}, propertiesIndex...), index...),
+			descending: key.Descending,
+		})
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		for _, f := range fields {
+			result := compareValues(v.Index(i).FieldByIndex(f.index), v.Index(j).FieldByIndex(f.index))
+			if result == 0 {
+				continue
+			}
+			if f.descending {
+				return result > 0
+			}
+			return result < 0
+		}
+		return false
+	})
+	return nil
+}
+
+//propertiesOf returns the index and type of the properties of an object type. Objects like Server have their
+//properties in a Properties field, relations like ServerStorageRelationProperties are properties themselves.
+func propertiesOf(t reflect.Type) ([]int, reflect.Type) {
+	if properties, ok := t.FieldByName("Properties"); ok && properties.Type.Kind() == reflect.Struct {
+		return properties.Index, properties.Type
+	}
+	return nil, t
+}
+
+//fieldIndex returns the index of the field of a struct with the given JSON name
+func fieldIndex(t reflect.Type, name string) ([]int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			return t.Field(i).Index, true
+		}
+	}
+	return nil, false
+}
+
+//isSortable reports whether values of a type can be compared by compareValues
+func isSortable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return t == reflect.TypeOf(GSTime{})
+}
+
+//compareValues returns -1, 0 or 1 if a is less than, equal to or greater than b
+func compareValues(a, b reflect.Value) int {
+	var less, greater bool
+	switch a.Kind() {
+	case reflect.String:
+		less, greater = a.String() < b.String(), a.String() > b.String()
+	case reflect.Bool:
+		less, greater = !a.Bool() && b.Bool(), a.Bool() && !b.Bool()
+	case reflect.Float32, reflect.Float64:
+		less, greater = a.Float() < b.Float(), a.Float() > b.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less, greater = a.Int() < b.Int(), a.Int() > b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		less, greater = a.Uint() < b.Uint(), a.Uint() > b.Uint()
+	case reflect.Struct:
+		x, y := a.Interface().(GSTime), b.Interface().(GSTime)
+		less, greater = x.Before(y.Time), x.After(y.Time)
+	}
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+//ListOptions selects and filters the objects returned by list functions, e.g. GetServerList, GetEventList or
+//GetServerStorageList. The zero value returns all objects with all properties.
+//
+//Fields is sent to the API, all other options are applied by the client after the list has been received.
+type ListOptions struct {
+	//Properties returned by the API (its fields query parameter), e.g. []string{"name", "labels"}. The UUID and
+	//creation time, and all properties needed for the other options are always requested as well. All properties
+	//are returned if it is empty.
+	Fields []string
+
+	//Only objects having all of these labels are returned
+	Labels []string
+
+	//Only objects with this status (e.g. "active") are returned
+	Status string
+
+	//Only objects in this location are returned
+	LocationUUID string
+
+	//Only objects whose name starts with this prefix are returned
+	NamePrefix string
+
+	//Only objects whose name matches this regular expression are returned
+	NameRegexp *regexp.Regexp
+
+	//Order of the returned objects. By default they are sorted by creation time and UUID, see SortList.
+	SortBy []SortKey
+
+	//Maximum number of returned objects (after filtering and sorting), 0 means no limit
+	Limit int
+}
+
+//listOptionsOf returns the options passed to a list function. At most one ListOptions can be passed.
+func listOptionsOf(opts []ListOptions) (ListOptions, error) {
+	switch len(opts) {
+	case 0:
+		return ListOptions{}, nil
+	case 1:
+		if opts[0].Limit < 0 {
+			return ListOptions{}, newArgumentError("'Limit' cannot be negative", "opts")
+		}
+		return opts[0], nil
+	}
+	return ListOptions{}, newArgumentError("only one ListOptions can be passed", "opts")
+}
+
+//query returns the query string of a list request, or an empty string if there is none
+func (o ListOptions) query() string {
+	if len(o.Fields) == 0 {
+		return ""
+	}
+	fields := append([]string{"object_uuid", "create_time"}, o.Fields...)
+	if len(o.Labels) > 0 {
+		fields = append(fields, "labels")
+	}
+	if o.Status != "" {
+		fields = append(fields, "status")
+	}
+	if o.LocationUUID != "" {
+		fields = append(fields, "location_uuid")
+	}
+	if o.NamePrefix != "" || o.NameRegexp != nil {
+		fields = append(fields, "name")
+	}
+	for _, key := range o.SortBy {
+		fields = append(fields, key.Property)
+	}
+	seen := make(map[string]bool)
+	var escaped []string
+	for _, field := range fields {
+		if !seen[field] {
+			seen[field] = true
+			escaped = append(escaped, url.QueryEscape(field))
+		}
+	}
+	return "?fields=" + strings.Join(escaped, ",")
+}
+
+//apply filters, sorts and limits the list a pointer to a slice of objects points to
+func (o ListOptions) apply(list interface{}) error {
+	v := reflect.ValueOf(list).Elem()
+	if len(o.SortBy) > 0 {
+		if err := sortList(v.Interface(), o.SortBy, true); err != nil {
+			return err
+		}
+	} else {
+		sortByDefault(v.Interface())
+	}
+	propertiesIndex, _ := propertiesOf(v.Type().Elem())
+	filtered := reflect.MakeSlice(v.Type(), 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		if o.Limit > 0 && filtered.Len() == o.Limit {
+			break
+		}
+		ok, err := o.matches(v.Index(i).FieldByIndex(propertiesIndex))
+		if err != nil {
+			return err
+		}
+		if ok {
+			filtered = reflect.Append(filtered, v.Index(i))
+		}
+	}
+	if filtered.Len() < v.Len() {
+		v.Set(filtered)
+	}
+	return nil
+}
+
+//matches reports whether the properties of an object match all predicates of the options
+func (o ListOptions) matches(properties reflect.Value) (bool, error) {
+	property := func(name string) (reflect.Value, error) {
+		index, ok := fieldIndex(properties.Type(), name)
+		if !ok {
+			return reflect.Value{}, newArgumentError(fmt.Sprintf("%s cannot be filtered by %s", properties.Type().Name(), name), "opts")
+		}
+		return properties.FieldByIndex(index), nil
+	}
+	if len(o.Labels) > 0 {
+		labels, err := property("labels")
+		if err != nil {
+			return false, err
+		}
+		for _, label := range o.Labels {
+			if !containsString(labels.Interface().([]string), label) {
+				return false, nil
+			}
+		}
+	}
+	if o.Status != "" {
+		status, err := property("status")
+		if err != nil || status.String() != o.Status {
+			return false, err
+		}
+	}
+	if o.LocationUUID != "" {
+		locationUUID, err := property("location_uuid")
+		if err != nil || locationUUID.String() != o.LocationUUID {
+			return false, err
+		}
+	}
+	if o.NamePrefix != "" || o.NameRegexp != nil {
+		name, err := property("name")
+		if err != nil {
+			return false, err
+		}
+		if !strings.HasPrefix(name.String(), o.NamePrefix) {
+			return false, nil
+		}
+		if o.NameRegexp != nil && !o.NameRegexp.MatchString(name.String()) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+//containsString reports whether a slice contains a string
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+package gsclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetServerList_Order(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	later := dummyTime.Add(time.Hour).Format(gsTimeLayout)
+	earlier := dummyTime.Format(gsTimeLayout)
+	mux.HandleFunc(apiServerBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"servers": {
+			"c": {"object_uuid": "c", "create_time": "%s"},
+			"b": {"object_uuid": "b", "create_time": "%s"},
+			"a": {"create_time": "%s"}
+		}}`, earlier, later, earlier)
+	})
+	for i := 0; i < 10; i++ {
+		servers, err := client.GetServerList(emptyCtx)
+		assert.Nil(t, err)
+		var uuids []string
+		for _, server := range servers {
+			uuids = append(uuids, server.Properties.ObjectUUID)
+		}
+		//the UUID of "a" is copied from the key of the map
+		assert.Equal(t, []string{"a", "c", "b"}, uuids)
+	}
+}
+
+func TestSortList(t *testing.T) {
+	storages := []Storage{
+		{Properties: StorageProperties{ObjectUUID: "a", Name: "db", Capacity: 10, CreateTime: dummyTime}},
+		{Properties: StorageProperties{ObjectUUID: "b", Name: "web", Capacity: 20, CreateTime: dummyTime}},
+		{Properties: StorageProperties{ObjectUUID: "c", Name: "app", Capacity: 10}},
+	}
+	uuids := func() string {
+		var result string
+		for _, storage := range storages {
+			result += storage.Properties.ObjectUUID
+		}
+		return result
+	}
+
+	assert.Nil(t, SortList(storages, SortKey{Property: "name"}))
+	assert.Equal(t, "cab", uuids())
+	assert.Nil(t, SortList(storages, SortKey{Property: "capacity", Descending: true}, SortKey{Property: "object_uuid"}))
+	assert.Equal(t, "bac", uuids())
+	assert.Nil(t, SortList(storages, SortKey{Property: "create_time"}, SortKey{Property: "object_uuid", Descending: true}))
+	assert.Equal(t, "cba", uuids())
+
+	err := SortList(storages, SortKey{Property: "unknown"})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	err = SortList(storages, SortKey{Property: "labels"})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	err = SortList([]string{"a"}, SortKey{Property: "name"})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestClient_GetStorageList_Options(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	var query string
+	mux.HandleFunc(apiStorageBase, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"storages": {
+			"a": {"object_uuid": "a", "name": "db-1", "status": "active", "labels": ["db", "prod"], "location_uuid": "fra"},
+			"b": {"object_uuid": "b", "name": "db-2", "status": "active", "labels": ["db"], "location_uuid": "fra"},
+			"c": {"object_uuid": "c", "name": "web-1", "status": "active", "labels": ["prod"], "location_uuid": "fra"},
+			"d": {"object_uuid": "d", "name": "db-3", "status": "in-provisioning", "labels": ["db", "prod"], "location_uuid": "fra"},
+			"e": {"object_uuid": "e", "name": "db-4", "status": "active", "labels": ["db", "prod"], "location_uuid": "ams"}
+		}}`)
+	})
+	uuids := func(storages []Storage) []string {
+		var result []string
+		for _, storage := range storages {
+			result = append(result, storage.Properties.ObjectUUID)
+		}
+		return result
+	}
+	testCases := []struct {
+		options ListOptions
+		query   string
+		uuids   []string
+	}{
+		{ListOptions{}, "", []string{"a", "b", "c", "d", "e"}},
+		{ListOptions{Labels: []string{"db", "prod"}}, "", []string{"a", "d", "e"}},
+		{ListOptions{Status: "active", LocationUUID: "fra"}, "", []string{"a", "b", "c"}},
+		{ListOptions{NamePrefix: "db-"}, "", []string{"a", "b", "d", "e"}},
+		{ListOptions{NameRegexp: regexp.MustCompile(`-[13]$`)}, "", []string{"a", "c", "d"}},
+		{ListOptions{NamePrefix: "db-", Limit: 2}, "", []string{"a", "b"}},
+		{ListOptions{SortBy: []SortKey{{Property: "name", Descending: true}}, Limit: 2}, "", []string{"c", "e"}},
+		{ListOptions{Fields: []string{"name", "capacity"}, Status: "active"}, "fields=object_uuid,create_time,name,capacity,status",
+			[]string{"a", "b", "c", "e"}},
+	}
+	for _, test := range testCases {
+		storages, err := client.GetStorageList(emptyCtx, test.options)
+		assert.Nil(t, err)
+		assert.Equal(t, test.uuids, uuids(storages))
+		assert.Equal(t, test.query, query)
+	}
+
+	_, err := client.GetStorageList(emptyCtx, ListOptions{}, ListOptions{})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	_, err = client.GetStorageList(emptyCtx, ListOptions{Limit: -1})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	_, err = client.GetStorageList(emptyCtx, ListOptions{SortBy: []SortKey{{Property: "unknown"}}})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestClient_GetLabelList_Options(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	mux.HandleFunc(apiLabelBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"labels": {"b": {"status": "active"}, "a": {"status": "active"}}}`)
+	})
+	labels, err := client.GetLabelList(emptyCtx, ListOptions{Status: "active"})
+	assert.Nil(t, err)
+	if assert.Len(t, labels, 2) {
+		assert.Equal(t, "a", labels[0].Properties.Label)
+	}
+	//labels have no location
+	_, err = client.GetLabelList(emptyCtx, ListOptions{LocationUUID: dummyUUID})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestClient_GetEventList_Options(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	var query string
+	mux.HandleFunc(apiEventBase, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"events": [
+			{"request_uuid": "b", "timestamp": "2018-04-28T09:47:42Z"},
+			{"request_uuid": "c", "timestamp": "2018-04-28T09:47:43Z"},
+			{"request_uuid": "a", "timestamp": "2018-04-28T09:47:41Z"}
+		]}`)
+	})
+	//events are sorted by their timestamp
+	events, err := client.GetEventList(emptyCtx, ListOptions{Fields: []string{"request_uuid", "timestamp"}, Limit: 2})
+	assert.Nil(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "a", events[0].Properties.RequestUUID)
+		assert.Equal(t, "b", events[1].Properties.RequestUUID)
+	}
+	assert.Equal(t, "fields=object_uuid,create_time,request_uuid,timestamp", query)
+	//events have no name
+	_, err = client.GetEventList(emptyCtx, ListOptions{NamePrefix: "a"})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestClient_GetServerStorageList_Options(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	mux.HandleFunc(path.Join(apiServerBase, dummyUUID, "storages"), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"storage_relations": [
+			{"object_uuid": "b", "object_name": "data", "capacity": 20},
+			{"object_uuid": "a", "object_name": "boot", "capacity": 10}
+		]}`)
+	})
+	//relations have no Properties field, they are filtered and sorted as they are
+	storages, err := client.GetServerStorageList(emptyCtx, dummyUUID)
+	assert.Nil(t, err)
+	if assert.Len(t, storages, 2) {
+		assert.Equal(t, "a", storages[0].ObjectUUID)
+	}
+	storages, err = client.GetServerStorageList(emptyCtx, dummyUUID, ListOptions{
+		SortBy: []SortKey{{Property: "capacity", Descending: true}},
+		Limit:  1,
+	})
+	assert.Nil(t, err)
+	if assert.Len(t, storages, 1) {
+		assert.Equal(t, "b", storages[0].ObjectUUID)
+	}
+}
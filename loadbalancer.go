@@ -1,11 +1,24 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//LoadBalancerOperator is an interface defining API of a loadbalancer operator
+type LoadBalancerOperator interface {
+	GetLoadBalancerList(ctx context.Context, opts ...ListOptions) ([]LoadBalancer, error)
+	LoadBalancersIter(ctx context.Context, yield func(LoadBalancer) bool) error
+	GetLoadBalancer(ctx context.Context, id string) (LoadBalancer, error)
+	CreateLoadBalancer(ctx context.Context, body LoadBalancerCreateRequest) (LoadBalancerCreateResponse, *Operation, error)
+	UpdateLoadBalancer(ctx context.Context, id string, body LoadBalancerUpdateRequest) (*Operation, error)
+	UpdateLoadBalancerFunc(ctx context.Context, id string, mutate func(*LoadBalancerProperties) error) (*Operation, error)
+	GetLoadBalancerEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+	DeleteLoadBalancer(ctx context.Context, id string) (*Operation, error)
+}
+
 //LoadBalancers is the JSON struct of a list of loadbalancers
 type LoadBalancers struct {
 	//Array of loadbalancers
@@ -135,37 +148,67 @@ type LoadBalancerCreateRequest struct {
 	Status string `json:"status,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r LoadBalancerCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	if r.Algorithm != LoadbalancerRoundrobinAlg && r.Algorithm != LoadbalancerLeastConnAlg {
+		v.add("algorithm", "has to be LoadbalancerRoundrobinAlg or LoadbalancerLeastConnAlg")
+	}
+	v.forwardingRules("forwarding_rules", r.ForwardingRules)
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //LoadBalancerUpdateRequest is the JSON struct for updating a loadbalancer request
 type LoadBalancerUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
 
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
-	ListenIPv6UUID string `json:"listen_ipv6_uuid"`
+	ListenIPv6UUID *string `json:"listen_ipv6_uuid,omitempty"`
 
 	//The UUID of the IPv4 address the loadbalancer will listen to for incoming requests.
-	ListenIPv4UUID string `json:"listen_ipv4_uuid"`
+	ListenIPv4UUID *string `json:"listen_ipv4_uuid,omitempty"`
 
 	//The algorithm used to process requests. Allowed values: `LoadbalancerRoundrobinAlg`, `LoadbalancerLeastConnAlg`
-	Algorithm loadbalancerAlgorithm `json:"algorithm"`
+	Algorithm *loadbalancerAlgorithm `json:"algorithm,omitempty"`
 
 	//An array of ForwardingRule objects containing the forwarding rules for the loadbalancer
-	ForwardingRules []ForwardingRule `json:"forwarding_rules"`
+	ForwardingRules *[]ForwardingRule `json:"forwarding_rules,omitempty"`
 
 	//The servers that this loadbalancer can communicate with
-	BackendServers []BackendServer `json:"backend_servers"`
+	BackendServers *[]BackendServer `json:"backend_servers,omitempty"`
 
 	//List of labels.
-	Labels []string `json:"labels"`
+	Labels *[]string `json:"labels,omitempty"`
 
 	//Helps to identify which datacenter an object belongs to.
-	LocationUUID string `json:"location_uuid"`
+	LocationUUID *string `json:"location_uuid,omitempty"`
 
 	//Whether the Load balancer is forced to redirect requests from HTTP to HTTPS
-	RedirectHTTPToHTTPS bool `json:"redirect_http_to_https"`
+	RedirectHTTPToHTTPS *bool `json:"redirect_http_to_https,omitempty"`
 
 	//Status indicates the status of the object.
-	Status string `json:"status,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r LoadBalancerUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Algorithm != nil && *r.Algorithm != LoadbalancerRoundrobinAlg && *r.Algorithm != LoadbalancerLeastConnAlg {
+		v.add("algorithm", "has to be LoadbalancerRoundrobinAlg or LoadbalancerLeastConnAlg")
+	}
+	if r.ForwardingRules != nil {
+		v.forwardingRules("forwarding_rules", *r.ForwardingRules)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
 }
 
 //LoadBalancerCreateResponse is the JSON struct for a loadbalancer response
@@ -186,33 +229,59 @@ var (
 //GetLoadBalancerList returns a list of loadbalancers
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLoadbalancers
-func (c *Client) GetLoadBalancerList() ([]LoadBalancer, error) {
+func (c *Client) GetLoadBalancerList(ctx context.Context, opts ...ListOptions) ([]LoadBalancer, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiLoadBalancerBase,
+		uri:    apiLoadBalancerBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response LoadBalancers
 	var loadBalancers []LoadBalancer
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		loadBalancers = append(loadBalancers, LoadBalancer{Properties: properties})
 	}
-	return loadBalancers, err
+	return loadBalancers, options.apply(&loadBalancers)
+}
+
+//LoadBalancersIter calls yield for all loadbalancers, in the order of the response. The response is decoded one
+//object at a time instead of being read into memory as a whole. Iterating stops early when yield returns false or
+//the context is done.
+func (c *Client) LoadBalancersIter(ctx context.Context, yield func(LoadBalancer) bool) error {
+	return c.iterate(ctx, apiLoadBalancerBase, "loadbalancers", func(key string, dec *json.Decoder) (bool, error) {
+		var loadBalancer LoadBalancer
+		if err := dec.Decode(&loadBalancer.Properties); err != nil {
+			return false, err
+		}
+		if loadBalancer.Properties.ObjectUUID == "" {
+			loadBalancer.Properties.ObjectUUID = key
+		}
+		return yield(loadBalancer), nil
+	})
 }
 
 //GetLoadBalancer returns a loadbalancer of a given uuid
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLoadbalancer
-func (c *Client) GetLoadBalancer(id string) (LoadBalancer, error) {
+func (c *Client) GetLoadBalancer(ctx context.Context, id string) (LoadBalancer, error) {
 	if !isValidUUID(id) {
-		return LoadBalancer{}, errors.New("'id' is invalid")
+		return LoadBalancer{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiLoadBalancerBase, id),
 		method: http.MethodGet,
 	}
 	var response LoadBalancer
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
@@ -221,7 +290,10 @@ func (c *Client) GetLoadBalancer(id string) (LoadBalancer, error) {
 //Note: loadbalancer's algorithm can only be either `LoadbalancerRoundrobinAlg` or `LoadbalancerLeastConnAlg`
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createLoadbalancer
-func (c *Client) CreateLoadBalancer(body LoadBalancerCreateRequest) (LoadBalancerCreateResponse, error) {
+func (c *Client) CreateLoadBalancer(ctx context.Context, body LoadBalancerCreateRequest) (LoadBalancerCreateResponse, *Operation, error) {
+	if body.LocationUUID == "" {
+		body.LocationUUID = c.cfg.locationUUID
+	}
 	if body.Labels == nil {
 		body.Labels = make([]string, 0)
 	}
@@ -231,14 +303,14 @@ func (c *Client) CreateLoadBalancer(body LoadBalancerCreateRequest) (LoadBalance
 		body:   body,
 	}
 	var response LoadBalancerCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return LoadBalancerCreateResponse{}, err
+		return LoadBalancerCreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "loadbalancer", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //UpdateLoadBalancer update configuration of a loadbalancer
@@ -246,85 +318,106 @@ func (c *Client) CreateLoadBalancer(body LoadBalancerCreateRequest) (LoadBalance
 //Note: loadbalancer's algorithm can only be either `LoadbalancerRoundrobinAlg` or `LoadbalancerLeastConnAlg`
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateLoadbalancer
-func (c *Client) UpdateLoadBalancer(id string, body LoadBalancerUpdateRequest) error {
+func (c *Client) UpdateLoadBalancer(ctx context.Context, id string, body LoadBalancerUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
-	}
-	if body.Labels == nil {
-		body.Labels = make([]string, 0)
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiLoadBalancerBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForLoadbalancerActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "loadbalancer", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForLoadbalancerActive(ctx, id)
+	})
+}
+
+//UpdateLoadBalancerFunc updates a loadbalancer by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateLoadBalancerFunc(ctx context.Context, id string, mutate func(*LoadBalancerProperties) error) (*Operation, error) {
+	var body LoadBalancerUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "loadbalancer",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetLoadBalancer(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*LoadBalancerProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateLoadBalancer(ctx, id, body)
+		},
+	})
 }
 
 //GetLoadBalancerEventList retrieves events of a given uuid
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLoadbalancerEvents
-func (c *Client) GetLoadBalancerEventList(id string) ([]Event, error) {
+func (c *Client) GetLoadBalancerEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLoadBalancerBase, id, "events"),
+		uri:    path.Join(apiLoadBalancerBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var loadBalancerEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		loadBalancerEvents = append(loadBalancerEvents, Event{Properties: properties})
 	}
-	return loadBalancerEvents, err
+	return loadBalancerEvents, options.apply(&loadBalancerEvents)
 }
 
 //DeleteLoadBalancer deletes a loadbalancer
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteLoadbalancer
-func (c *Client) DeleteLoadBalancer(id string) error {
+func (c *Client) DeleteLoadBalancer(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiLoadBalancerBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForLoadbalancerDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "loadbalancer", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForLoadbalancerDeleted(ctx, id)
+	})
 }
 
 //waitForLoadbalancerActive allows to wait until the loadbalancer's status is active
-func (c *Client) waitForLoadbalancerActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		lb, err := c.GetLoadBalancer(id)
-		return lb.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForLoadbalancerActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetLoadBalancer(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForLoadbalancerDeleted allows to wait until the loadbalancer is deleted
-func (c *Client) waitForLoadbalancerDeleted(id string) error {
+func (c *Client) waitForLoadbalancerDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiLoadBalancerBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
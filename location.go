@@ -1,11 +1,17 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 )
 
+//LocationOperator is an interface defining API of a location operator
+type LocationOperator interface {
+	GetLocationList(ctx context.Context, opts ...ListOptions) ([]Location, error)
+	GetLocation(ctx context.Context, id string) (Location, error)
+}
+
 //LocationList JSON struct of a list of locations
 type LocationList struct {
 	//Array of locations
@@ -42,32 +48,42 @@ type LocationProperties struct {
 //GetLocationList gets a list of available locations]
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocations
-func (c *Client) GetLocationList() ([]Location, error) {
+func (c *Client) GetLocationList(ctx context.Context, opts ...ListOptions) ([]Location, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiLocationBase,
+		uri:    apiLocationBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response LocationList
 	var locations []Location
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		locations = append(locations, Location{Properties: properties})
 	}
-	return locations, err
+	return locations, options.apply(&locations)
 }
 
 //GetLocation gets a specific location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocation
-func (c *Client) GetLocation(id string) (Location, error) {
+func (c *Client) GetLocation(ctx context.Context, id string) (Location, error) {
 	if !isValidUUID(id) {
-		return Location{}, errors.New("'id' is invalid")
+		return Location{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiLocationBase, id),
 		method: http.MethodGet,
 	}
 	var location Location
-	err := r.execute(*c, &location)
+	err := r.execute(ctx, c, &location)
 	return location, err
 }
@@ -0,0 +1,74 @@
+package gsclient
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+//Fields are structured key-value pairs attached to a log entry
+type Fields map[string]interface{}
+
+//with returns a copy of the fields extended by other fields
+func (f Fields) with(other Fields) Fields {
+	fields := make(Fields, len(f)+len(other))
+	for key, value := range f {
+		fields[key] = value
+	}
+	for key, value := range other {
+		fields[key] = value
+	}
+	return fields
+}
+
+//Logger is a leveled logger with structured fields. Any logging library can be used with gsclient
+//by implementing this interface.
+type Logger interface {
+	Debug(msg string, fields Fields)
+	Info(msg string, fields Fields)
+	Warn(msg string, fields Fields)
+	Error(msg string, fields Fields)
+}
+
+//NoopLogger is a logger discarding all log entries
+type NoopLogger struct{}
+
+//Debug implements Logger
+func (NoopLogger) Debug(msg string, fields Fields) {}
+
+//Info implements Logger
+func (NoopLogger) Info(msg string, fields Fields) {}
+
+//Warn implements Logger
+func (NoopLogger) Warn(msg string, fields Fields) {}
+
+//Error implements Logger
+func (NoopLogger) Error(msg string, fields Fields) {}
+
+//logrusLogger adapts a logrus logger to Logger
+type logrusLogger struct {
+	logger logrus.FieldLogger
+}
+
+//NewLogrusLogger creates a Logger writing to a logrus logger (*logrus.Logger or *logrus.Entry)
+func NewLogrusLogger(logger logrus.FieldLogger) Logger {
+	return logrusLogger{logger: logger}
+}
+
+//Debug implements Logger
+func (l logrusLogger) Debug(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Debug(msg)
+}
+
+//Info implements Logger
+func (l logrusLogger) Info(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Info(msg)
+}
+
+//Warn implements Logger
+func (l logrusLogger) Warn(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Warn(msg)
+}
+
+//Error implements Logger
+func (l logrusLogger) Error(msg string, fields Fields) {
+	l.logger.WithFields(logrus.Fields(fields)).Error(msg)
+}
@@ -0,0 +1,57 @@
+//go:build go1.21
+// +build go1.21
+
+package gsclient
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+)
+
+//slogLogger adapts a log/slog logger to Logger
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+//NewSlogLogger creates a Logger writing to a log/slog logger
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return slogLogger{logger: logger}
+}
+
+//Debug implements Logger
+func (l slogLogger) Debug(msg string, fields Fields) {
+	l.log(slog.LevelDebug, msg, fields)
+}
+
+//Info implements Logger
+func (l slogLogger) Info(msg string, fields Fields) {
+	l.log(slog.LevelInfo, msg, fields)
+}
+
+//Warn implements Logger
+func (l slogLogger) Warn(msg string, fields Fields) {
+	l.log(slog.LevelWarn, msg, fields)
+}
+
+//Error implements Logger
+func (l slogLogger) Error(msg string, fields Fields) {
+	l.log(slog.LevelError, msg, fields)
+}
+
+func (l slogLogger) log(level slog.Level, msg string, fields Fields) {
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, key := range keys {
+		attrs = append(attrs, slog.Any(key, fields[key]))
+	}
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
@@ -0,0 +1,37 @@
+//go:build go1.21
+// +build go1.21
+
+package gsclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSlogLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := NewSlogLogger(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	logger.Debug("hidden", Fields{"method": "GET"})
+	assert.Equal(t, 0, buf.Len())
+
+	levelTestCases := map[string]func(string, Fields){
+		"INFO":  logger.Info,
+		"WARN":  logger.Warn,
+		"ERROR": logger.Error,
+	}
+	for level, log := range levelTestCases {
+		buf.Reset()
+		log("test message", Fields{"method": "GET", "status_code": 200})
+		var entry map[string]interface{}
+		assert.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.Equal(t, level, entry["level"])
+		assert.Equal(t, "test message", entry["msg"])
+		assert.Equal(t, "GET", entry["method"])
+		assert.Equal(t, float64(200), entry["status_code"])
+	}
+}
@@ -0,0 +1,100 @@
+package gsclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type logEntry struct {
+	level  string
+	msg    string
+	fields Fields
+}
+
+//recordingLogger keeps all log entries in memory
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) log(level, msg string, fields Fields) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, logEntry{level, msg, fields})
+}
+
+func (l *recordingLogger) Debug(msg string, fields Fields) { l.log("debug", msg, fields) }
+func (l *recordingLogger) Info(msg string, fields Fields)  { l.log("info", msg, fields) }
+func (l *recordingLogger) Warn(msg string, fields Fields)  { l.log("warn", msg, fields) }
+func (l *recordingLogger) Error(msg string, fields Fields) { l.log("error", msg, fields) }
+
+func (l *recordingLogger) find(msg string) (logEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, entry := range l.entries {
+		if entry.msg == msg {
+			return entry, true
+		}
+	}
+	return logEntry{}, false
+}
+
+func TestNewLogrusLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logrusLogger := logrus.New()
+	logrusLogger.Out = buf
+	logrusLogger.Level = logrus.DebugLevel
+	logrusLogger.Formatter = &logrus.JSONFormatter{}
+	logger := NewLogrusLogger(logrusLogger)
+	levelTestCases := map[string]func(string, Fields){
+		"debug":   logger.Debug,
+		"info":    logger.Info,
+		"warning": logger.Warn,
+		"error":   logger.Error,
+	}
+	for level, log := range levelTestCases {
+		buf.Reset()
+		log("test message", Fields{"method": http.MethodGet, "status_code": 200})
+		var entry map[string]interface{}
+		assert.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.Equal(t, level, entry["level"])
+		assert.Equal(t, "test message", entry["msg"])
+		assert.Equal(t, http.MethodGet, entry["method"])
+		assert.Equal(t, float64(200), entry["status_code"])
+	}
+}
+
+func TestConfig_SetLogger(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	logger := &recordingLogger{}
+	client.cfg.SetLogger(logger)
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set(requestUUIDHeader, dummyRequestUUID)
+		writer.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(writer, `{"title": "bad request", "description": "invalid name"}`)
+	})
+	_, err := client.GetServerList(emptyCtx)
+	assert.NotNil(t, err)
+	entry, ok := logger.find("Request failed")
+	assert.True(t, ok, "request failure has not been logged")
+	assert.Equal(t, "error", entry.level)
+	assert.Equal(t, Fields{
+		"method":       http.MethodGet,
+		"uri":          apiServerBase,
+		"status_code":  http.StatusBadRequest,
+		"request_uuid": dummyRequestUUID,
+		"title":        "bad request",
+		"description":  "invalid name",
+	}, entry.fields)
+
+	client.cfg.SetLogger(nil)
+	assert.Equal(t, NoopLogger{}, client.cfg.logger)
+}
@@ -0,0 +1,134 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+//MiddlewareRequest is a single attempt of a request to the API, as seen by middlewares.
+//Middlewares may modify it before passing it on.
+type MiddlewareRequest struct {
+	//HTTP method of the request
+	Method string
+
+	//URI of the request relative to the API URL, e.g. /objects/servers
+	URI string
+
+	//Headers sent with the request, including the authentication headers
+	Header http.Header
+
+	//JSON body of the request. Can be empty.
+	Body []byte
+
+	//Number of the attempt, starting at 1. It increases when the request is retried.
+	Attempt int
+}
+
+//MiddlewareResponse is the outcome of a request to the API, as seen by middlewares
+type MiddlewareResponse struct {
+	//HTTP status code. 0 => no response has been received, Err has to be set.
+	StatusCode int
+
+	//Headers of the response
+	Header http.Header
+
+	//Body of the response. It is empty for successful responses of iterators (e.g. ServersIter), which decode the body
+	//while it is read.
+	Body []byte
+
+	//Error of the request. It is a RequestError if the status code is 300 or higher, otherwise an error which
+	//occurred while sending the request or reading the response.
+	Err error
+
+	//Time between sending the request and receiving the whole response
+	Latency time.Duration
+}
+
+//RequestHandler sends a request to the API and returns its outcome
+type RequestHandler func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse
+
+//Middleware wraps a RequestHandler. It can inspect and modify the request before calling the next handler,
+//inspect and modify the response afterwards, or return a response without calling the next handler at all.
+//
+//Middlewares are called for every attempt of every request, including the requests polling the status
+//of an object in synchronous mode.
+type Middleware func(next RequestHandler) RequestHandler
+
+//cloneHeader returns a deep copy of an http header
+func cloneHeader(header http.Header) http.Header {
+	clone := make(http.Header, len(header))
+	for key, values := range header {
+		clone[key] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+//HeaderMiddleware sets additional headers on every request
+func HeaderMiddleware(header http.Header) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+			for key, values := range header {
+				req.Header[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+//redactedHeaders are headers whose values are not written by DumpMiddleware
+var redactedHeaders = map[string]bool{
+	"X-Auth-Token": true,
+}
+
+//DumpMiddleware writes every request and its response to w. The API token is redacted.
+func DumpMiddleware(w io.Writer) Middleware {
+	var mu sync.Mutex
+	return func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+			res := next(ctx, req)
+			mu.Lock()
+			defer mu.Unlock()
+			fmt.Fprintf(w, "> %s %s (attempt %d)\n", req.Method, req.URI, req.Attempt)
+			dumpHeader(w, ">", req.Header)
+			if len(req.Body) > 0 {
+				fmt.Fprintf(w, ">\n> %s\n", req.Body)
+			}
+			if res == nil {
+				return res
+			}
+			if res.StatusCode == 0 {
+				fmt.Fprintf(w, "< error after %v: %v\n\n", res.Latency, res.Err)
+				return res
+			}
+			fmt.Fprintf(w, "< %d %s (%v)\n", res.StatusCode, http.StatusText(res.StatusCode), res.Latency)
+			dumpHeader(w, "<", res.Header)
+			if len(res.Body) > 0 {
+				fmt.Fprintf(w, "<\n< %s\n", res.Body)
+			}
+			fmt.Fprintln(w)
+			return res
+		}
+	}
+}
+
+//dumpHeader writes headers sorted by name
+func dumpHeader(w io.Writer, prefix string, header http.Header) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range header[key] {
+			if redactedHeaders[key] {
+				value = "[REDACTED]"
+			}
+			fmt.Fprintf(w, "%s %s: %s\n", prefix, key, value)
+		}
+	}
+}
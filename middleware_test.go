@@ -0,0 +1,122 @@
+package gsclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_AddMiddleware(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	mux.HandleFunc(apiStorageBase, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test", r.Header.Get("X-Test"))
+		fmt.Fprint(w, prepareStorageCreateResponse())
+	})
+	mux.HandleFunc(requestBase, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test", r.Header.Get("X-Test"))
+		fmt.Fprint(w, fmt.Sprintf(`{"%s": {"status":"done"}}`, dummyRequestUUID))
+	})
+	var mu sync.Mutex
+	var calls []string
+	recorder := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+				mu.Lock()
+				calls = append(calls, fmt.Sprintf("%s > %s %s", name, req.Method, req.URI))
+				mu.Unlock()
+				res := next(ctx, req)
+				mu.Lock()
+				calls = append(calls, fmt.Sprintf("%s < %d", name, res.StatusCode))
+				mu.Unlock()
+				return res
+			}
+		}
+	}
+	client.cfg.AddMiddleware(recorder("outer"), HeaderMiddleware(http.Header{"X-Test": {"test"}}), recorder("inner"))
+	_, _, err := client.CreateStorage(emptyCtx, StorageCreateRequest{
+		Capacity:     10,
+		LocationUUID: dummyUUID,
+		Name:         "test",
+	})
+	assert.Nil(t, err, "CreateStorage returned an error %v", err)
+	//the request polling the status in synchronous mode passes through the middlewares as well
+	assert.Equal(t, []string{
+		"outer > POST " + apiStorageBase,
+		"inner > POST " + apiStorageBase,
+		"inner < 200",
+		"outer < 200",
+		"outer > GET " + path.Join(requestBase, dummyRequestUUID),
+		"inner > GET " + path.Join(requestBase, dummyRequestUUID),
+		"inner < 200",
+		"outer < 200",
+	}, calls)
+}
+
+func TestMiddleware_faultInjection(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, prepareServerListHTTPGet("active"))
+	})
+	client.cfg.SetRetryPolicy(&ExponentialBackoffRetryPolicy{MaxRetries: 3})
+	client.cfg.AddMiddleware(func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+			if req.Attempt == 1 {
+				return &MiddlewareResponse{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{},
+					Err:        RequestError{StatusCode: http.StatusServiceUnavailable},
+				}
+			}
+			return next(ctx, req)
+		}
+	})
+	res, err := client.GetServerList(emptyCtx)
+	assert.Nil(t, err, "GetServerList returned an error %v", err)
+	assert.Equal(t, 1, len(res))
+}
+
+func TestMiddleware_emptyResponse(t *testing.T) {
+	server, client, _ := setupTestClient(false)
+	defer server.Close()
+	client.cfg.AddMiddleware(func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+			return &MiddlewareResponse{}
+		}
+	})
+	res, err := client.GetServerList(emptyCtx)
+	assert.EqualError(t, err, "middleware returned no response")
+	assert.Empty(t, res)
+}
+
+func TestDumpMiddleware(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	mux.HandleFunc(apiStorageBase, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, prepareStorageCreateResponse())
+	})
+	buf := new(bytes.Buffer)
+	client.cfg.AddMiddleware(DumpMiddleware(buf))
+	_, _, err := client.CreateStorage(emptyCtx, StorageCreateRequest{
+		Capacity:     10,
+		LocationUUID: dummyUUID,
+		Name:         "test",
+	})
+	assert.Nil(t, err, "CreateStorage returned an error %v", err)
+	dump := buf.String()
+	assert.True(t, strings.HasPrefix(dump, "> POST "+apiStorageBase+" (attempt 1)\n"), dump)
+	assert.Contains(t, dump, "> X-Auth-Token: [REDACTED]\n")
+	assert.Contains(t, dump, "> X-Auth-Userid: uuid\n")
+	assert.NotContains(t, dump, "token\n")
+	assert.Contains(t, dump, `"name":"test"`)
+	assert.Contains(t, dump, "< 200 OK")
+	assert.Contains(t, dump, prepareStorageCreateResponse())
+}
@@ -1,12 +1,28 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"path"
 )
 
+//NetworkOperator is an interface defining API of a network operator
+type NetworkOperator interface {
+	GetNetwork(ctx context.Context, id string) (Network, error)
+	CreateNetwork(ctx context.Context, body NetworkCreateRequest) (NetworkCreateResponse, *Operation, error)
+	DeleteNetwork(ctx context.Context, id string) (*Operation, error)
+	UpdateNetwork(ctx context.Context, id string, body NetworkUpdateRequest) (*Operation, error)
+	UpdateNetworkFunc(ctx context.Context, id string, mutate func(*NetworkProperties) error) (*Operation, error)
+	GetNetworkList(ctx context.Context, opts ...ListOptions) ([]Network, error)
+	NetworksIter(ctx context.Context, yield func(Network) bool) error
+	GetNetworkEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+	GetNetworkPublic(ctx context.Context) (Network, error)
+	GetNetworksByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Network, error)
+	GetDeletedNetworks(ctx context.Context, opts ...ListOptions) ([]Network, error)
+}
+
 //NetworkList is JSON struct of a list of networks
 type NetworkList struct {
 	//Array of networks
@@ -141,6 +157,14 @@ type NetworkCreateRequest struct {
 	L2Security bool `json:"l2security,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r NetworkCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //NetworkCreateResponse is JSON of a response for creating a network
 type NetworkCreateResponse struct {
 	//UUID of the network being created
@@ -153,135 +177,198 @@ type NetworkCreateResponse struct {
 //NetworkUpdateRequest is JSON of a request for updating a network
 type NetworkUpdateRequest struct {
 	//New name. Leave it if you do not want to update the name
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//L2Security. Leave it if you do not want to update the l2 security
-	L2Security bool `json:"l2security"`
+	L2Security *bool `json:"l2security,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r NetworkUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	return v.err()
 }
 
 //GetNetwork get a specific network based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getNetwork
-func (c *Client) GetNetwork(id string) (Network, error) {
+func (c *Client) GetNetwork(ctx context.Context, id string) (Network, error) {
 	if !isValidUUID(id) {
-		return Network{}, errors.New("'id' is invalid")
+		return Network{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiNetworkBase, id),
 		method: http.MethodGet,
 	}
 	var response Network
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //CreateNetwork creates a network
 //
 //See: https://gridscale.io/en//api-documentation/index.html#tag/network
-func (c *Client) CreateNetwork(body NetworkCreateRequest) (NetworkCreateResponse, error) {
+func (c *Client) CreateNetwork(ctx context.Context, body NetworkCreateRequest) (NetworkCreateResponse, *Operation, error) {
+	if body.LocationUUID == "" {
+		body.LocationUUID = c.cfg.locationUUID
+	}
 	r := Request{
 		uri:    apiNetworkBase,
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response NetworkCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return NetworkCreateResponse{}, err
+		return NetworkCreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "network", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //DeleteNetwork deletes a specific network based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteNetwork
-func (c *Client) DeleteNetwork(id string) error {
+func (c *Client) DeleteNetwork(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiNetworkBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForNetworkDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "network", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForNetworkDeleted(ctx, id)
+	})
 }
 
 //UpdateNetwork updates a specific network based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateNetwork
-func (c *Client) UpdateNetwork(id string, body NetworkUpdateRequest) error {
+func (c *Client) UpdateNetwork(ctx context.Context, id string, body NetworkUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiNetworkBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForNetworkActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "network", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForNetworkActive(ctx, id)
+	})
+}
+
+//UpdateNetworkFunc updates a network by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateNetworkFunc(ctx context.Context, id string, mutate func(*NetworkProperties) error) (*Operation, error) {
+	var body NetworkUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "network",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetNetwork(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*NetworkProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateNetwork(ctx, id, body)
+		},
+	})
 }
 
 //GetNetworkList gets a list of available networks
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getNetworks
-func (c *Client) GetNetworkList() ([]Network, error) {
+func (c *Client) GetNetworkList(ctx context.Context, opts ...ListOptions) ([]Network, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiNetworkBase,
+		uri:    apiNetworkBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response NetworkList
 	var networks []Network
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		networks = append(networks, Network{
 			Properties: properties,
 		})
 	}
-	return networks, err
+	return networks, options.apply(&networks)
+}
+
+//NetworksIter calls yield for all networks, in the order of the response. The response is decoded one object at a
+//time instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) NetworksIter(ctx context.Context, yield func(Network) bool) error {
+	return c.iterate(ctx, apiNetworkBase, "networks", func(key string, dec *json.Decoder) (bool, error) {
+		var network Network
+		if err := dec.Decode(&network.Properties); err != nil {
+			return false, err
+		}
+		if network.Properties.ObjectUUID == "" {
+			network.Properties.ObjectUUID = key
+		}
+		return yield(network), nil
+	})
 }
 
 //GetNetworkEventList gets a list of a network's events
 //
 //See: https://gridscale.io/en//api-documentation/index.html#tag/network
-func (c *Client) GetNetworkEventList(id string) ([]Event, error) {
+func (c *Client) GetNetworkEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiNetworkBase, id, "events"),
+		uri:    path.Join(apiNetworkBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var networkEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		networkEvents = append(networkEvents, Event{Properties: properties})
 	}
-	return networkEvents, err
+	return networkEvents, options.apply(&networkEvents)
 }
 
 //GetNetworkPublic gets public network
-func (c *Client) GetNetworkPublic() (Network, error) {
-	networks, err := c.GetNetworkList()
+func (c *Client) GetNetworkPublic(ctx context.Context) (Network, error) {
+	networks, err := c.GetNetworkList(ctx)
 	if err != nil {
 		return Network{}, err
 	}
@@ -290,60 +377,79 @@ func (c *Client) GetNetworkPublic() (Network, error) {
 			return Network{Properties: network.Properties}, nil
 		}
 	}
-	return Network{}, fmt.Errorf("Public Network not found")
+	return Network{}, fmt.Errorf("Public Network %w", ErrNotFound)
 }
 
 //GetNetworksByLocation gets a list of networks by location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedNetworks
-func (c *Client) GetNetworksByLocation(id string) ([]Network, error) {
+func (c *Client) GetNetworksByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Network, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLocationBase, id, "networks"),
+		uri:    path.Join(apiLocationBase, id, "networks") + options.query(),
 		method: http.MethodGet,
 	}
 	var response NetworkList
 	var networks []Network
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		networks = append(networks, Network{Properties: properties})
 	}
-	return networks, err
+	return networks, options.apply(&networks)
 }
 
 //GetDeletedNetworks gets a list of deleted networks
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedNetworks
-func (c *Client) GetDeletedNetworks() ([]Network, error) {
+func (c *Client) GetDeletedNetworks(ctx context.Context, opts ...ListOptions) ([]Network, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "networks"),
+		uri:    path.Join(apiDeletedBase, "networks") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedNetworkList
 	var networks []Network
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		networks = append(networks, Network{Properties: properties})
 	}
-	return networks, err
+	return networks, options.apply(&networks)
 }
 
 //waitForNetworkActive allows to wait until the network's status is active
-func (c *Client) waitForNetworkActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		net, err := c.GetNetwork(id)
-		return net.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForNetworkActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetNetwork(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForNetworkDeleted allows to wait until the network is deleted
-func (c *Client) waitForNetworkDeleted(id string) error {
+func (c *Client) waitForNetworkDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiNetworkBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -1,12 +1,21 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 	"strings"
 )
 
+//ObjectStorageOperator is an interface defining API of an object storage operator
+type ObjectStorageOperator interface {
+	GetObjectStorageAccessKeyList(ctx context.Context, opts ...ListOptions) ([]ObjectStorageAccessKey, error)
+	GetObjectStorageAccessKey(ctx context.Context, id string) (ObjectStorageAccessKey, error)
+	CreateObjectStorageAccessKey(ctx context.Context) (ObjectStorageAccessKeyCreateResponse, *Operation, error)
+	DeleteObjectStorageAccessKey(ctx context.Context, id string) (*Operation, error)
+	GetObjectStorageBucketList(ctx context.Context, opts ...ListOptions) ([]ObjectStorageBucket, error)
+}
+
 //ObjectStorageAccessKeyList is JSON structure of a list of Object Storage Access Keys
 type ObjectStorageAccessKeyList struct {
 	//Array of Object Storages' access keys
@@ -75,100 +84,112 @@ type ObjectStorageBucketProperties struct {
 //GetObjectStorageAccessKeyList gets a list of available object storage access keys
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getAccessKeys
-func (c *Client) GetObjectStorageAccessKeyList() ([]ObjectStorageAccessKey, error) {
+func (c *Client) GetObjectStorageAccessKeyList(ctx context.Context, opts ...ListOptions) ([]ObjectStorageAccessKey, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiObjectStorageBase, "access_keys"),
+		uri:    path.Join(apiObjectStorageBase, "access_keys") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ObjectStorageAccessKeyList
 	var accessKeys []ObjectStorageAccessKey
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		accessKeys = append(accessKeys, ObjectStorageAccessKey{Properties: properties})
 	}
-	return accessKeys, err
+	return accessKeys, options.apply(&accessKeys)
 }
 
 //GetObjectStorageAccessKey gets a specific object storage access key based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getAccessKey
-func (c *Client) GetObjectStorageAccessKey(id string) (ObjectStorageAccessKey, error) {
+func (c *Client) GetObjectStorageAccessKey(ctx context.Context, id string) (ObjectStorageAccessKey, error) {
 	if strings.TrimSpace(id) == "" {
-		return ObjectStorageAccessKey{}, errors.New("'id' is required")
+		return ObjectStorageAccessKey{}, newArgumentError("'id' is required", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiObjectStorageBase, "access_keys", id),
 		method: http.MethodGet,
 	}
 	var response ObjectStorageAccessKey
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //CreateObjectStorageAccessKey creates an object storage access key
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createAccessKey
-func (c *Client) CreateObjectStorageAccessKey() (ObjectStorageAccessKeyCreateResponse, error) {
+func (c *Client) CreateObjectStorageAccessKey(ctx context.Context) (ObjectStorageAccessKeyCreateResponse, *Operation, error) {
 	r := Request{
 		uri:    path.Join(apiObjectStorageBase, "access_keys"),
 		method: http.MethodPost,
 	}
 	var response ObjectStorageAccessKeyCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return ObjectStorageAccessKeyCreateResponse{}, err
+		return ObjectStorageAccessKeyCreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "object_storage_access_key", response.AccessKey.AccessKey, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //DeleteObjectStorageAccessKey deletes a specific object storage access key based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteAccessKey
-func (c *Client) DeleteObjectStorageAccessKey(id string) error {
+func (c *Client) DeleteObjectStorageAccessKey(ctx context.Context, id string) (*Operation, error) {
 	if strings.TrimSpace(id) == "" {
-		return errors.New("'id' is required")
+		return nil, newArgumentError("'id' is required", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiObjectStorageBase, "access_keys", id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForObjectStorageAccessKeyDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "object_storage_access_key", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForObjectStorageAccessKeyDeleted(ctx, id)
+	})
 }
 
 //GetObjectStorageBucketList gets a list of object storage buckets
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getBuckets
-func (c *Client) GetObjectStorageBucketList() ([]ObjectStorageBucket, error) {
+func (c *Client) GetObjectStorageBucketList(ctx context.Context, opts ...ListOptions) ([]ObjectStorageBucket, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiObjectStorageBase, "buckets"),
+		uri:    path.Join(apiObjectStorageBase, "buckets") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ObjectStorageBucketList
 	var buckets []ObjectStorageBucket
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		buckets = append(buckets, ObjectStorageBucket{Properties: properties})
 	}
-	return buckets, err
+	return buckets, options.apply(&buckets)
 }
 
 //waitForObjectStorageAccessKeyDeleted allows to wait until the object storage's access key is deleted
-func (c *Client) waitForObjectStorageAccessKeyDeleted(id string) error {
+func (c *Client) waitForObjectStorageAccessKeyDeleted(ctx context.Context, id string) error {
 	if strings.TrimSpace(id) == "" {
-		return errors.New("'id' is required")
+		return newArgumentError("'id' is required", "id")
 	}
 	uri := path.Join(apiObjectStorageBase, "access_keys", id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -0,0 +1,195 @@
+package gsclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+//OperationKind describes what an operation does to its object
+type OperationKind string
+
+//All available operation kinds
+const (
+	OperationCreate   OperationKind = "create"
+	OperationUpdate   OperationKind = "update"
+	OperationDelete   OperationKind = "delete"
+	OperationPowerOn  OperationKind = "power_on"
+	OperationPowerOff OperationKind = "power_off"
+	OperationShutdown OperationKind = "shutdown"
+	OperationRollback OperationKind = "rollback"
+	OperationExport   OperationKind = "export"
+)
+
+//OperationStatus is the status of an operation as far as the client knows it
+type OperationStatus string
+
+//All available operation statuses
+const (
+	//OperationPending means that the operation has not been seen done by Wait or Poll yet
+	OperationPending OperationStatus = "pending"
+
+	//OperationSucceeded means that the operation is done
+	OperationSucceeded OperationStatus = "succeeded"
+
+	//OperationFailed means that the operation has failed, see Operation.Err
+	OperationFailed OperationStatus = "failed"
+)
+
+//Operation is a handle of a change which has been sent to the API. It is returned by all functions
+//which create, update or delete objects. In synchronous mode it is already done when it is returned,
+//otherwise Wait can be used to block until the change has been applied, or Poll to check it without blocking.
+type Operation struct {
+	//UUID of the request, empty if the server did not return one
+	RequestUUID string
+
+	//UUID of the changed object. For relations (e.g. a storage linked to a server) it is the UUID of the server.
+	ObjectUUID string
+
+	//Type of the changed object, e.g. "server", "storage" or "server_storage"
+	ObjectType string
+
+	//What has been done to the object
+	Kind OperationKind
+
+	//wait blocks until the operation is done, nil if there is nothing to wait for
+	wait func(ctx context.Context) error
+
+	//client used by Poll to get the status of the request, nil if there is nothing to wait for
+	client *Client
+
+	//waitMu serializes concurrent calls of Wait
+	waitMu sync.Mutex
+
+	//mu guards status and err
+	mu     sync.Mutex
+	status OperationStatus
+	err    error
+}
+
+//newOperation creates an operation. An operation without wait function is done right away.
+func newOperation(kind OperationKind, objectType, objectUUID, requestUUID string, wait func(ctx context.Context) error) *Operation {
+	op := &Operation{
+		RequestUUID: requestUUID,
+		ObjectUUID:  objectUUID,
+		ObjectType:  objectType,
+		Kind:        kind,
+		wait:        wait,
+		status:      OperationPending,
+	}
+	if wait == nil {
+		op.status = OperationSucceeded
+	}
+	return op
+}
+
+//startOperation creates an operation for a request which has been executed successfully.
+//In synchronous mode it blocks until the operation is done.
+func (c *Client) startOperation(ctx context.Context, kind OperationKind, objectType, objectUUID, requestUUID string,
+	wait func(ctx context.Context) error) (*Operation, error) {
+	op := newOperation(kind, objectType, objectUUID, requestUUID, wait)
+	op.client = c
+	if c.cfg.sync {
+		return op, op.Wait(ctx)
+	}
+	return op, nil
+}
+
+//Wait blocks until the operation is done, the operation has failed or the context is done.
+//Once the operation is done (or has failed), Wait returns immediately with the same result.
+//Wait can be called concurrently.
+func (op *Operation) Wait(ctx context.Context) error {
+	op.waitMu.Lock()
+	defer op.waitMu.Unlock()
+	if status, err := op.state(); status != OperationPending {
+		return err
+	}
+	err := op.wait(ctx)
+	if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		//waiting has been interrupted, the operation itself may still succeed
+		return err
+	}
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	op.err = err
+	op.status = OperationSucceeded
+	if err != nil {
+		op.status = OperationFailed
+	}
+	return err
+}
+
+//Poll gets the status of the request of a pending operation once, without waiting for it to be done, and
+//returns the updated status of the operation. An error getting the status is returned as is and leaves the
+//operation pending. If the operation has no request UUID, its status can only be changed by Wait.
+//
+//Unlike Wait, Poll only checks the request, e.g. it does not wait until a deleted object returns 404.
+func (op *Operation) Poll(ctx context.Context) (OperationStatus, error) {
+	status, err := op.state()
+	if status != OperationPending || op.client == nil || op.RequestUUID == "" {
+		return status, err
+	}
+	done, err := op.client.checkRequest(ctx, op.RequestUUID)
+	if !done {
+		return OperationPending, err
+	}
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	if op.status == OperationPending {
+		op.err = err
+		op.status = OperationSucceeded
+		if err != nil {
+			op.status = OperationFailed
+		}
+	}
+	return op.status, op.err
+}
+
+//Done reports whether the operation is known to be done (or failed). It does not send any requests, see Poll.
+func (op *Operation) Done() bool {
+	status, _ := op.state()
+	return status != OperationPending
+}
+
+//Status returns the status of the operation. It does not send any requests, see Poll.
+func (op *Operation) Status() OperationStatus {
+	status, _ := op.state()
+	return status
+}
+
+//Err returns the error which made the operation fail, nil if it has not failed (yet)
+func (op *Operation) Err() error {
+	_, err := op.state()
+	return err
+}
+
+//state returns status and error of the operation
+func (op *Operation) state() (OperationStatus, error) {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	return op.status, op.err
+}
+
+//WaitAll waits for all operations in parallel and returns the first error that occurred.
+//Nil operations are skipped.
+func WaitAll(ctx context.Context, ops ...*Operation) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(ops))
+	for i, op := range ops {
+		if op == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, op *Operation) {
+			defer wg.Done()
+			errs[i] = op.Wait(ctx)
+		}(i, op)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
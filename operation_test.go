@@ -0,0 +1,113 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_DeleteStorageOperation(t *testing.T) {
+	for _, clientTest := range syncClientTestCases {
+		server, client, mux := setupTestClient(clientTest)
+		var deleted int32
+		mux.HandleFunc(path.Join(apiStorageBase, dummyUUID), func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodDelete:
+				atomic.StoreInt32(&deleted, 1)
+				w.Header().Set(requestUUIDHeader, dummyRequestUUID)
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodGet:
+				if atomic.LoadInt32(&deleted) == 1 {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, prepareStorageHTTPGet("active"))
+			}
+		})
+		op, err := client.DeleteStorage(emptyCtx, dummyUUID)
+		assert.Nil(t, err, "DeleteStorage returned an error %v", err)
+		assert.Equal(t, dummyRequestUUID, op.RequestUUID)
+		assert.Equal(t, dummyUUID, op.ObjectUUID)
+		assert.Equal(t, "storage", op.ObjectType)
+		assert.Equal(t, OperationDelete, op.Kind)
+		if clientTest {
+			assert.True(t, op.Done())
+			assert.Equal(t, OperationSucceeded, op.Status())
+		} else {
+			assert.False(t, op.Done())
+			assert.Equal(t, OperationPending, op.Status())
+			assert.Nil(t, op.Wait(emptyCtx))
+			assert.True(t, op.Done())
+			assert.Equal(t, OperationSucceeded, op.Status())
+		}
+		assert.Nil(t, op.Err())
+		server.Close()
+	}
+}
+
+func TestOperation_Wait(t *testing.T) {
+	var calls int32
+	op := newOperation(OperationUpdate, "server", dummyUUID, dummyRequestUUID, func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return ErrTimeout
+	})
+	assert.Equal(t, ErrTimeout, op.Wait(emptyCtx))
+	assert.Equal(t, ErrTimeout, op.Wait(emptyCtx))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.True(t, op.Done())
+	assert.Equal(t, OperationFailed, op.Status())
+	assert.Equal(t, ErrTimeout, op.Err())
+}
+
+func TestOperation_WaitInterrupted(t *testing.T) {
+	op := newOperation(OperationCreate, "storage", dummyUUID, dummyRequestUUID, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	ctx, cancel := context.WithTimeout(emptyCtx, 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, op.Wait(ctx))
+	assert.False(t, op.Done())
+	assert.Equal(t, OperationPending, op.Status())
+}
+
+func TestOperation_WithoutWait(t *testing.T) {
+	op := newOperation(OperationUpdate, "server_storage", dummyUUID, "", nil)
+	assert.True(t, op.Done())
+	assert.Nil(t, op.Wait(emptyCtx))
+}
+
+func TestWaitAll(t *testing.T) {
+	var running, maxRunning int32
+	ops := make([]*Operation, 10)
+	for i := range ops {
+		ops[i] = newOperation(OperationCreate, "storage", dummyUUID, dummyRequestUUID, func(ctx context.Context) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+	assert.Nil(t, WaitAll(emptyCtx, append(ops, nil)...))
+	assert.True(t, atomic.LoadInt32(&maxRunning) > 1)
+	for _, op := range ops {
+		assert.True(t, op.Done())
+	}
+
+	failed := newOperation(OperationCreate, "storage", dummyUUID, dummyRequestUUID, func(ctx context.Context) error {
+		return ErrTimeout
+	})
+	assert.Equal(t, ErrTimeout, WaitAll(emptyCtx, ops[0], failed))
+}
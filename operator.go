@@ -0,0 +1,57 @@
+package gsclient
+
+//go:generate go run ./internal/mockgen -o gsclientmock/mocks.go
+
+//Operator is an interface defining the whole API of the client. Code which depends on Operator, or on one of
+//the smaller operator interfaces, can be tested with the mocks of the gsclientmock package.
+type Operator interface {
+	RequestOperator
+	BulkOperator
+	EventOperator
+	FirewallOperator
+	IPOperator
+	ISOImageOperator
+	LabelOperator
+	LoadBalancerOperator
+	LocationOperator
+	NetworkOperator
+	ObjectStorageOperator
+	PaaSOperator
+	ServerOperator
+	ServerIPRelationOperator
+	ServerIsoImageRelationOperator
+	ServerNetworkRelationOperator
+	ServerStorageRelationOperator
+	StorageOperator
+	StorageSnapshotOperator
+	StorageSnapshotScheduleOperator
+	SshKeyOperator
+	TemplateOperator
+}
+
+//Client implements all operator interfaces
+var (
+	_ Operator                        = (*Client)(nil)
+	_ RequestOperator                 = (*Client)(nil)
+	_ BulkOperator                    = (*Client)(nil)
+	_ EventOperator                   = (*Client)(nil)
+	_ FirewallOperator                = (*Client)(nil)
+	_ IPOperator                      = (*Client)(nil)
+	_ ISOImageOperator                = (*Client)(nil)
+	_ LabelOperator                   = (*Client)(nil)
+	_ LoadBalancerOperator            = (*Client)(nil)
+	_ LocationOperator                = (*Client)(nil)
+	_ NetworkOperator                 = (*Client)(nil)
+	_ ObjectStorageOperator           = (*Client)(nil)
+	_ PaaSOperator                    = (*Client)(nil)
+	_ ServerOperator                  = (*Client)(nil)
+	_ ServerIPRelationOperator        = (*Client)(nil)
+	_ ServerIsoImageRelationOperator  = (*Client)(nil)
+	_ ServerNetworkRelationOperator   = (*Client)(nil)
+	_ ServerStorageRelationOperator   = (*Client)(nil)
+	_ StorageOperator                 = (*Client)(nil)
+	_ StorageSnapshotOperator         = (*Client)(nil)
+	_ StorageSnapshotScheduleOperator = (*Client)(nil)
+	_ SshKeyOperator                  = (*Client)(nil)
+	_ TemplateOperator                = (*Client)(nil)
+)
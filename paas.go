@@ -1,11 +1,32 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//PaaSOperator is an interface defining API of a PaaS operator
+type PaaSOperator interface {
+	GetPaaSServiceList(ctx context.Context, opts ...ListOptions) ([]PaaSService, error)
+	PaaSServicesIter(ctx context.Context, yield func(PaaSService) bool) error
+	CreatePaaSService(ctx context.Context, body PaaSServiceCreateRequest) (PaaSServiceCreateResponse, *Operation, error)
+	GetPaaSService(ctx context.Context, id string) (PaaSService, error)
+	UpdatePaaSService(ctx context.Context, id string, body PaaSServiceUpdateRequest) (*Operation, error)
+	UpdatePaaSServiceFunc(ctx context.Context, id string, mutate func(*PaaSServiceProperties) error) (*Operation, error)
+	DeletePaaSService(ctx context.Context, id string) (*Operation, error)
+	GetPaaSServiceMetrics(ctx context.Context, id string, opts ...ListOptions) ([]PaaSServiceMetric, error)
+	GetPaaSTemplateList(ctx context.Context, opts ...ListOptions) ([]PaaSTemplate, error)
+	GetPaaSSecurityZoneList(ctx context.Context, opts ...ListOptions) ([]PaaSSecurityZone, error)
+	CreatePaaSSecurityZone(ctx context.Context, body PaaSSecurityZoneCreateRequest) (PaaSSecurityZoneCreateResponse, *Operation, error)
+	GetPaaSSecurityZone(ctx context.Context, id string) (PaaSSecurityZone, error)
+	UpdatePaaSSecurityZone(ctx context.Context, id string, body PaaSSecurityZoneUpdateRequest) (*Operation, error)
+	UpdatePaaSSecurityZoneFunc(ctx context.Context, id string, mutate func(*PaaSSecurityZoneProperties) error) (*Operation, error)
+	DeletePaaSSecurityZone(ctx context.Context, id string) (*Operation, error)
+	GetDeletedPaaSServices(ctx context.Context, opts ...ListOptions) ([]PaaSService, error)
+}
+
 //PaaSServices is the JSON struct of a list of PaaS services
 type PaaSServices struct {
 	//Array of PaaS services
@@ -103,6 +124,15 @@ type PaaSServiceCreateRequest struct {
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r PaaSServiceCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.required("paas_service_template_uuid", r.PaaSServiceTemplateUUID)
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //ResourceLimit is JSON struct of resource limit
 type ResourceLimit struct {
 	//The name of the resource you would like to cap.
@@ -218,16 +248,28 @@ type Resource struct {
 type PaaSServiceUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
 	//Leave it if you do not want to update the name
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//List of labels. Leave it if you do not want to update the list of labels
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
 
 	//Contains the service parameters for the service. Leave it if you do not want to update the parameters
-	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
 
 	//A list of service resource limits. Leave it if you do not want to update the resource limits
-	ResourceLimits []ResourceLimit `json:"resource_limits,omitempty"`
+	ResourceLimits *[]ResourceLimit `json:"resource_limits,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r PaaSServiceUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
 }
 
 //PaaSServiceMetrics JSON of a list of PaaS metrics
@@ -338,6 +380,13 @@ type PaaSSecurityZoneCreateRequest struct {
 	LocationUUID string `json:"location_uuid,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r PaaSSecurityZoneCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, true)
+	return v.err()
+}
+
 //PaaSSecurityZoneCreateResponse JSON struct of a response for creating a PaaS security zone
 type PaaSSecurityZoneCreateResponse struct {
 	//UUID of the request
@@ -353,308 +402,414 @@ type PaaSSecurityZoneCreateResponse struct {
 //PaaSSecurityZoneUpdateRequest JSON struct of a request for updating a PaaS security zone
 type PaaSSecurityZoneUpdateRequest struct {
 	//The new name you give to the security zone. Leave it if you do not want to update the name
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//Identifies which datacenter the object belongs to. Leave it if you do not want to update the location
-	LocationUUID string `json:"location_uuid,omitempty"`
+	LocationUUID *string `json:"location_uuid,omitempty"`
 
 	//The UUID for the security zone you would like to update. Leave it if you do not want to update the security zone
-	PaaSSecurityZoneUUID string `json:"paas_security_zone_uuid,omitempty"`
+	PaaSSecurityZoneUUID *string `json:"paas_security_zone_uuid,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r PaaSSecurityZoneUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	return v.err()
 }
 
 //GetPaaSServiceList returns a list of PaaS Services
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getPaasServices
-func (c *Client) GetPaaSServiceList() ([]PaaSService, error) {
+func (c *Client) GetPaaSServiceList(ctx context.Context, opts ...ListOptions) ([]PaaSService, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiPaaSBase, "services"),
+		uri:    path.Join(apiPaaSBase, "services") + options.query(),
 		method: http.MethodGet,
 	}
 	var response PaaSServices
 	var paasServices []PaaSService
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		paasServices = append(paasServices, PaaSService{
 			Properties: properties,
 		})
 	}
-	return paasServices, err
+	return paasServices, options.apply(&paasServices)
+}
+
+//PaaSServicesIter calls yield for all PaaS services, in the order of the response. The response is decoded one
+//object at a time instead of being read into memory as a whole. Iterating stops early when yield returns false or
+//the context is done.
+func (c *Client) PaaSServicesIter(ctx context.Context, yield func(PaaSService) bool) error {
+	return c.iterate(ctx, path.Join(apiPaaSBase, "services"), "paas_services", func(key string, dec *json.Decoder) (bool, error) {
+		var paasService PaaSService
+		if err := dec.Decode(&paasService.Properties); err != nil {
+			return false, err
+		}
+		if paasService.Properties.ObjectUUID == "" {
+			paasService.Properties.ObjectUUID = key
+		}
+		return yield(paasService), nil
+	})
 }
 
 //CreatePaaSService creates a new PaaS service
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createPaasService
-func (c *Client) CreatePaaSService(body PaaSServiceCreateRequest) (PaaSServiceCreateResponse, error) {
+func (c *Client) CreatePaaSService(ctx context.Context, body PaaSServiceCreateRequest) (PaaSServiceCreateResponse, *Operation, error) {
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "services"),
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response PaaSServiceCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return PaaSServiceCreateResponse{}, err
-	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
+		return PaaSServiceCreateResponse{}, nil, err
 	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "paas_service", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //GetPaaSService returns a specific PaaS Service based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getPaasService
-func (c *Client) GetPaaSService(id string) (PaaSService, error) {
+func (c *Client) GetPaaSService(ctx context.Context, id string) (PaaSService, error) {
 	if !isValidUUID(id) {
-		return PaaSService{}, errors.New("'id' is invalid")
+		return PaaSService{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "services", id),
 		method: http.MethodGet,
 	}
 	var response PaaSService
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //UpdatePaaSService updates a specific PaaS Service based on a given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updatePaasService
-func (c *Client) UpdatePaaSService(id string, body PaaSServiceUpdateRequest) error {
+func (c *Client) UpdatePaaSService(ctx context.Context, id string, body PaaSServiceUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "services", id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForPaaSServiceActive(id)
-	}
-	return r.execute(*c, nil)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.startOperation(ctx, OperationUpdate, "paas_service", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForPaaSServiceActive(ctx, id)
+	})
+}
+
+//UpdatePaaSServiceFunc updates a PaaS service by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdatePaaSServiceFunc(ctx context.Context, id string, mutate func(*PaaSServiceProperties) error) (*Operation, error) {
+	var body PaaSServiceUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "paas_service",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetPaaSService(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*PaaSServiceProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdatePaaSService(ctx, id, body)
+		},
+	})
 }
 
 //DeletePaaSService deletes a PaaS service
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deletePaasService
-func (c *Client) DeletePaaSService(id string) error {
+func (c *Client) DeletePaaSService(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "services", id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForPaaSServiceDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "paas_service", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForPaaSServiceDeleted(ctx, id)
+	})
 }
 
 //GetPaaSServiceMetrics get a specific PaaS Service's metrics based on a given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getPaasServiceMetrics
-func (c *Client) GetPaaSServiceMetrics(id string) ([]PaaSServiceMetric, error) {
+func (c *Client) GetPaaSServiceMetrics(ctx context.Context, id string, opts ...ListOptions) ([]PaaSServiceMetric, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiPaaSBase, "services", id, "metrics"),
+		uri:    path.Join(apiPaaSBase, "services", id, "metrics") + options.query(),
 		method: http.MethodGet,
 	}
 	var response PaaSServiceMetrics
 	var metrics []PaaSServiceMetric
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		metrics = append(metrics, PaaSServiceMetric{
 			Properties: properties,
 		})
 	}
-	return metrics, err
+	return metrics, options.apply(&metrics)
 }
 
 //GetPaaSTemplateList returns a list of PaaS service templates
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getPaasServiceTemplates
-func (c *Client) GetPaaSTemplateList() ([]PaaSTemplate, error) {
+func (c *Client) GetPaaSTemplateList(ctx context.Context, opts ...ListOptions) ([]PaaSTemplate, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiPaaSBase, "service_templates"),
+		uri:    path.Join(apiPaaSBase, "service_templates") + options.query(),
 		method: http.MethodGet,
 	}
 	var response PaaSTemplates
 	var paasTemplates []PaaSTemplate
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		paasTemplate := PaaSTemplate{
 			Properties: properties,
 		}
 		paasTemplates = append(paasTemplates, paasTemplate)
 	}
-	return paasTemplates, err
+	return paasTemplates, options.apply(&paasTemplates)
 }
 
 //GetPaaSSecurityZoneList get available security zones
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getPaasSecurityZones
-func (c *Client) GetPaaSSecurityZoneList() ([]PaaSSecurityZone, error) {
+func (c *Client) GetPaaSSecurityZoneList(ctx context.Context, opts ...ListOptions) ([]PaaSSecurityZone, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiPaaSBase, "security_zones"),
+		uri:    path.Join(apiPaaSBase, "security_zones") + options.query(),
 		method: http.MethodGet,
 	}
 	var response PaaSSecurityZones
 	var securityZones []PaaSSecurityZone
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		securityZones = append(securityZones, PaaSSecurityZone{
 			Properties: properties,
 		})
 	}
-	return securityZones, err
+	return securityZones, options.apply(&securityZones)
 }
 
 //CreatePaaSSecurityZone creates a new PaaS security zone
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createPaasSecurityZone
-func (c *Client) CreatePaaSSecurityZone(body PaaSSecurityZoneCreateRequest) (PaaSSecurityZoneCreateResponse, error) {
+func (c *Client) CreatePaaSSecurityZone(ctx context.Context, body PaaSSecurityZoneCreateRequest) (PaaSSecurityZoneCreateResponse, *Operation, error) {
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "security_zones"),
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response PaaSSecurityZoneCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return PaaSSecurityZoneCreateResponse{}, err
-	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
+		return PaaSSecurityZoneCreateResponse{}, nil, err
 	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "paas_security_zone", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //GetPaaSSecurityZone get a specific PaaS Security Zone based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getPaasSecurityZone
-func (c *Client) GetPaaSSecurityZone(id string) (PaaSSecurityZone, error) {
+func (c *Client) GetPaaSSecurityZone(ctx context.Context, id string) (PaaSSecurityZone, error) {
 	if !isValidUUID(id) {
-		return PaaSSecurityZone{}, errors.New("'id' is invalid")
+		return PaaSSecurityZone{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "security_zones", id),
 		method: http.MethodGet,
 	}
 	var response PaaSSecurityZone
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //UpdatePaaSSecurityZone update a specific PaaS security zone based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updatePaasSecurityZone
-func (c *Client) UpdatePaaSSecurityZone(id string, body PaaSSecurityZoneUpdateRequest) error {
+func (c *Client) UpdatePaaSSecurityZone(ctx context.Context, id string, body PaaSSecurityZoneUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "security_zones", id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSecurityZoneActive(id)
-	}
-	return r.execute(*c, nil)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.startOperation(ctx, OperationUpdate, "paas_security_zone", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSecurityZoneActive(ctx, id)
+	})
+}
+
+//UpdatePaaSSecurityZoneFunc updates a PaaS security zone by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdatePaaSSecurityZoneFunc(ctx context.Context, id string, mutate func(*PaaSSecurityZoneProperties) error) (*Operation, error) {
+	var body PaaSSecurityZoneUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "paas_security_zone",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetPaaSSecurityZone(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*PaaSSecurityZoneProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdatePaaSSecurityZone(ctx, id, body)
+		},
+	})
 }
 
 //DeletePaaSSecurityZone delete a specific PaaS Security Zone based on given id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deletePaasSecurityZone
-func (c *Client) DeletePaaSSecurityZone(id string) error {
+func (c *Client) DeletePaaSSecurityZone(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiPaaSBase, "security_zones", id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSecurityZoneDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "paas_security_zone", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSecurityZoneDeleted(ctx, id)
+	})
 }
 
 //GetDeletedPaaSServices returns a list of deleted PaaS Services
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedPaasServices
-func (c *Client) GetDeletedPaaSServices() ([]PaaSService, error) {
+func (c *Client) GetDeletedPaaSServices(ctx context.Context, opts ...ListOptions) ([]PaaSService, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "paas_services"),
+		uri:    path.Join(apiDeletedBase, "paas_services") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedPaaSServices
 	var paasServices []PaaSService
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		paasServices = append(paasServices, PaaSService{
 			Properties: properties,
 		})
 	}
-	return paasServices, err
+	return paasServices, options.apply(&paasServices)
 }
 
 //waitForPaaSServiceActive allows to wait until the PaaS service's status is active
-func (c *Client) waitForPaaSServiceActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		paas, err := c.GetPaaSService(id)
-		return paas.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForPaaSServiceActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetPaaSService(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForPaaSServiceDeleted allows to wait until the PaaS service is deleted
-func (c *Client) waitForPaaSServiceDeleted(id string) error {
+func (c *Client) waitForPaaSServiceDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiPaaSBase, "services", id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
 
 //waitForSecurityZoneActive allows to wait until the security zone's status is active
-func (c *Client) waitForSecurityZoneActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		secZone, err := c.GetPaaSSecurityZone(id)
-		return secZone.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForSecurityZoneActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetPaaSSecurityZone(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForSecurityZoneDeleted allows to wait until the security zone is deleted
-func (c *Client) waitForSecurityZoneDeleted(id string) error {
+func (c *Client) waitForSecurityZoneDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiPaaSBase, "security_zones", id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
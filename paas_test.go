@@ -18,7 +18,7 @@ func TestClient_GetPaaSServiceList(t *testing.T) {
 		assert.Equal(t, r.Method, http.MethodGet)
 		fmt.Fprint(w, preparePaaSHTTPGetListResponse("active"))
 	})
-	paasList, err := client.GetPaaSServiceList()
+	paasList, err := client.GetPaaSServiceList(emptyCtx)
 	assert.Nil(t, err, "GetPaaSServiceList returned an error %v", err)
 	assert.Equal(t, 1, len(paasList))
 	assert.Equal(t, fmt.Sprintf("[%v]", expectedObj), fmt.Sprintf("%v", paasList))
@@ -34,7 +34,7 @@ func TestClient_GetPaaSService(t *testing.T) {
 		fmt.Fprint(w, preparePaaSHTTPGetResponse("active"))
 	})
 	for _, test := range uuidCommonTestCases {
-		paas, err := client.GetPaaSService(test.testUUID)
+		paas, err := client.GetPaaSService(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -66,7 +66,7 @@ func TestClient_CreatePaaSService(t *testing.T) {
 		}
 		for _, test := range commonSuccessFailTestCases {
 			isFailed = test.isFailed
-			response, err := client.CreatePaaSService(PaaSServiceCreateRequest{
+			response, _, err := client.CreatePaaSService(emptyCtx, PaaSServiceCreateRequest{
 				Name:                    "test",
 				PaaSServiceTemplateUUID: "test-template",
 				Labels:                  []string{"label"},
@@ -111,11 +111,11 @@ func TestClient_UpdatePaaSService(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, test := range uuidCommonTestCases {
-				err := client.UpdatePaaSService(test.testUUID, PaaSServiceUpdateRequest{
-					Name:       "test",
-					Labels:     []string{"label"},
-					Parameters: parameters,
-					ResourceLimits: []ResourceLimit{
+				_, err := client.UpdatePaaSService(emptyCtx, test.testUUID, PaaSServiceUpdateRequest{
+					Name:       String("test"),
+					Labels:     Strings("label"),
+					Parameters: &parameters,
+					ResourceLimits: &[]ResourceLimit{
 						{
 							Resource: "cpu",
 							Limit:    2,
@@ -152,7 +152,7 @@ func TestClient_DeletePaaSService(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, test := range uuidCommonTestCases {
-				err := client.DeletePaaSService(test.testUUID)
+				_, err := client.DeletePaaSService(emptyCtx, test.testUUID)
 				if test.isFailed || isFailed {
 					assert.NotNil(t, err)
 				} else {
@@ -173,7 +173,7 @@ func TestClient_GetPaaSServiceMetrics(t *testing.T) {
 		fmt.Fprintf(writer, preparePaaSHTTPGetMetricsResponse())
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetPaaSServiceMetrics(test.testUUID)
+		res, err := client.GetPaaSServiceMetrics(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -192,7 +192,7 @@ func TestClient_GetPaaSTemplateList(t *testing.T) {
 		assert.Equal(t, request.Method, http.MethodGet)
 		fmt.Fprintf(writer, preparePaaSHTTPGetTemplatesResponse())
 	})
-	res, err := client.GetPaaSTemplateList()
+	res, err := client.GetPaaSTemplateList(emptyCtx)
 	assert.Nil(t, err, "GetPaaSTemplateList returned an error %v", err)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockPaasTemplate()), fmt.Sprintf("%v", res))
@@ -206,7 +206,7 @@ func TestClient_GetPaaSSecurityZoneList(t *testing.T) {
 		assert.Equal(t, request.Method, http.MethodGet)
 		fmt.Fprintf(writer, preparePaaSHTTPGetSecurityZoneList("active"))
 	})
-	res, err := client.GetPaaSSecurityZoneList()
+	res, err := client.GetPaaSSecurityZoneList(emptyCtx)
 	assert.Nil(t, err, "GetPaaSSecurityZone returned an error %v", err)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockSecurityZone("active")), fmt.Sprintf("%v", res))
@@ -231,7 +231,7 @@ func TestClient_CreatePaaSSecurityZone(t *testing.T) {
 		})
 		for _, test := range commonSuccessFailTestCases {
 			isFailed = test.isFailed
-			res, err := client.CreatePaaSSecurityZone(PaaSSecurityZoneCreateRequest{
+			res, _, err := client.CreatePaaSSecurityZone(emptyCtx, PaaSSecurityZoneCreateRequest{
 				Name:         "test",
 				LocationUUID: "aa-bb-cc",
 			})
@@ -255,7 +255,7 @@ func TestClient_GetPaaSSecurityZone(t *testing.T) {
 		fmt.Fprintf(writer, preparePaaSHTTPGetSecurityZone("active"))
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetPaaSSecurityZone(test.testUUID)
+		res, err := client.GetPaaSSecurityZone(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -284,10 +284,10 @@ func TestClient_UpdatePaaSSecurityZone(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, test := range uuidCommonTestCases {
-				err := client.UpdatePaaSSecurityZone(test.testUUID, PaaSSecurityZoneUpdateRequest{
-					Name:                 "test",
-					LocationUUID:         "a-b-c",
-					PaaSSecurityZoneUUID: dummyUUID,
+				_, err := client.UpdatePaaSSecurityZone(emptyCtx, test.testUUID, PaaSSecurityZoneUpdateRequest{
+					Name:                 String("test"),
+					LocationUUID:         String("a-b-c"),
+					PaaSSecurityZoneUUID: String(dummyUUID),
 				})
 				if test.isFailed || isFailed {
 					assert.NotNil(t, err)
@@ -319,7 +319,7 @@ func TestClient_DeletePaaSSecurityZone(t *testing.T) {
 		for _, serverTest := range commonSuccessFailTestCases {
 			isFailed = serverTest.isFailed
 			for _, test := range uuidCommonTestCases {
-				err := client.DeletePaaSSecurityZone(test.testUUID)
+				_, err := client.DeletePaaSSecurityZone(emptyCtx, test.testUUID)
 				if test.isFailed || isFailed {
 					assert.NotNil(t, err)
 				} else {
@@ -340,7 +340,7 @@ func TestClient_GetDeletedPaaSServices(t *testing.T) {
 		assert.Equal(t, r.Method, http.MethodGet)
 		fmt.Fprint(w, prepareDeletedPaaSHTTPGetListResponse("active"))
 	})
-	paasList, err := client.GetDeletedPaaSServices()
+	paasList, err := client.GetDeletedPaaSServices(emptyCtx)
 	assert.Nil(t, err, "GetDeletedPaaSServices returned an error %v", err)
 	assert.Equal(t, 1, len(paasList))
 	assert.Equal(t, fmt.Sprintf("[%v]", expectedObj), fmt.Sprintf("%v", paasList))
@@ -354,7 +354,7 @@ func TestClient_waitForPaaSServiceActive(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		fmt.Fprint(w, preparePaaSHTTPGetResponse("active"))
 	})
-	err := client.waitForPaaSServiceActive(dummyUUID)
+	err := client.waitForPaaSServiceActive(emptyCtx, dummyUUID)
 	assert.Nil(t, err, "waitForPaaSServiceActive returned an error %v", err)
 }
 
@@ -367,7 +367,7 @@ func TestClient_waitForPaaSServiceDeleted(t *testing.T) {
 		w.WriteHeader(404)
 	})
 	for _, test := range uuidCommonTestCases {
-		err := client.waitForPaaSServiceDeleted(test.testUUID)
+		err := client.waitForPaaSServiceDeleted(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -384,7 +384,7 @@ func TestClient_waitForSecurityZoneActive(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		fmt.Fprint(w, preparePaaSHTTPGetSecurityZone("active"))
 	})
-	err := client.waitForSecurityZoneActive(dummyUUID)
+	err := client.waitForSecurityZoneActive(emptyCtx, dummyUUID)
 	assert.Nil(t, err, "waitForSecurityZoneActive returned an error %v", err)
 }
 
@@ -397,7 +397,7 @@ func TestClient_waitForSecurityZoneDeleted(t *testing.T) {
 		w.WriteHeader(404)
 	})
 	for _, test := range uuidCommonTestCases {
-		err := client.waitForSecurityZoneDeleted(test.testUUID)
+		err := client.waitForSecurityZoneDeleted(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -0,0 +1,220 @@
+package gsclient
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultProfileName = "default"
+	envProfile         = "GRIDSCALE_PROFILE"
+	envProfileFile     = "GRIDSCALE_CONFIG_FILE"
+)
+
+//ProfileFile JSON/YAML struct of a file containing named profiles, e.g.
+//
+//	default_profile: dev
+//	profiles:
+//	  dev:
+//	    user_uuid: 690de890-13c0-4e76-8a01-e10ba8786e53
+//	    api_token: secret
+//	    location_uuid: 45ed677b-3702-4b36-be2a-a2eab9827950
+//	  prod:
+//	    api_url: https://api.gridscale.io
+//	    user_uuid: 0bd6a6f3-23a9-4b0a-8a51-7ad1e3ba1a45
+//	    api_token: another-secret
+//	    sync: true
+//	    request_check_timeout_secs: 300
+type ProfileFile struct {
+	//Name of the profile used when no profile is chosen. Can be empty, "default" is used then.
+	DefaultProfile string `json:"default_profile" yaml:"default_profile"`
+
+	//Profiles by name
+	Profiles map[string]Profile `json:"profiles" yaml:"profiles"`
+
+	//Path of the file the profiles have been read from
+	path string
+}
+
+//Profile JSON/YAML struct of a named set of settings for a gridscale project
+type Profile struct {
+	//Base URL of API. Can be empty, the default API URL is used then.
+	APIURL string `json:"api_url" yaml:"api_url"`
+
+	//UUID of user.
+	UserUUID string `json:"user_uuid" yaml:"user_uuid"`
+
+	//API token.
+	APIToken string `json:"api_token" yaml:"api_token"`
+
+	//Default location, used when an object is created without a location. Can be empty.
+	LocationUUID string `json:"location_uuid" yaml:"location_uuid"`
+
+	//true => client is in synchronous mode.
+	Sync bool `json:"sync" yaml:"sync"`
+
+	//Timeout (in second) for checking requests (for synchronous feature). Can be empty.
+	RequestCheckTimeoutSecs int `json:"request_check_timeout_secs" yaml:"request_check_timeout_secs"`
+
+	//Delay (in MilliSecond) between requests when checking request. Can be empty.
+	DelayIntervalMilliSecs int `json:"delay_interval_millisecs" yaml:"delay_interval_millisecs"`
+
+	//Number of retries when server returns 5xx, 424, 429 error code. Can be empty.
+	MaxNumberOfRetries int `json:"max_number_of_retries" yaml:"max_number_of_retries"`
+}
+
+//DefaultProfilePath returns the path of the profile file used when no path is given,
+//which is $GRIDSCALE_CONFIG_FILE or ~/.gridscale/config.yaml.
+func DefaultProfilePath() (string, error) {
+	if path := os.Getenv(envProfileFile); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".gridscale", "config.yaml"), nil
+}
+
+//LoadProfiles reads a YAML or JSON file containing named profiles. All profiles are validated.
+//path="" => DefaultProfilePath is used.
+func LoadProfiles(path string) (*ProfileFile, error) {
+	if path == "" {
+		var err error
+		path, err = DefaultProfilePath()
+		if err != nil {
+			return nil, err
+		}
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file ProfileFile
+	//YAML is a superset of JSON, so both formats are read by the YAML parser
+	err = yaml.UnmarshalStrict(content, &file)
+	if err != nil {
+		return nil, fmt.Errorf("profile file %s is malformed: %v", path, err)
+	}
+	file.path = path
+	if len(file.Profiles) == 0 {
+		return nil, fmt.Errorf("profile file %s does not contain any profiles", path)
+	}
+	if file.DefaultProfile != "" {
+		if _, ok := file.Profiles[file.DefaultProfile]; !ok {
+			return nil, fmt.Errorf("profile file %s: default profile %q does not exist", path, file.DefaultProfile)
+		}
+	}
+	var problems []string
+	for _, name := range file.ProfileNames() {
+		for _, problem := range file.Profiles[name].validate() {
+			problems = append(problems, fmt.Sprintf("profile %q: %s", name, problem))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("profile file %s is invalid: %s", path, strings.Join(problems, "; "))
+	}
+	return &file, nil
+}
+
+//ProfileNames returns the sorted names of all profiles
+func (f *ProfileFile) ProfileNames() []string {
+	names := make([]string, 0, len(f.Profiles))
+	for name := range f.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//Config creates a new config for a profile. Environment variables (see ConfigFromEnv) override the profile's values,
+//options passed to it are applied afterwards.
+//
+//name="" => $GRIDSCALE_PROFILE, the file's default profile or "default" is used.
+func (f *ProfileFile) Config(name string, opts ...Option) (*Config, error) {
+	if name == "" {
+		name = os.Getenv(envProfile)
+	}
+	if name == "" {
+		name = f.DefaultProfile
+	}
+	if name == "" {
+		name = defaultProfileName
+	}
+	profile, ok := f.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q does not exist in %s (available profiles: %s)",
+			name, f.path, strings.Join(f.ProfileNames(), ", "))
+	}
+	envOpts, err := envOptions()
+	if err != nil {
+		return nil, err
+	}
+	profileOpts := append(profile.options(), envOpts...)
+	cfg := NewConfig(append(profileOpts, opts...)...)
+	if cfg.userUUID == "" || cfg.apiToken == "" {
+		return nil, fmt.Errorf("profile %q in %s: 'user_uuid' and 'api_token' are required", name, f.path)
+	}
+	return cfg, nil
+}
+
+//ConfigFromProfile reads a profile file and creates a new config for one of its profiles.
+//path="" => DefaultProfilePath is used. name="" => see ProfileFile.Config.
+func ConfigFromProfile(path, name string, opts ...Option) (*Config, error) {
+	file, err := LoadProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+	return file.Config(name, opts...)
+}
+
+//options turns the profile's values into options
+func (p Profile) options() []Option {
+	return []Option{
+		WithAPIURL(p.APIURL),
+		WithCredentials(p.UserUUID, p.APIToken),
+		WithLocationUUID(p.LocationUUID),
+		WithSync(p.Sync),
+		WithTimeouts(
+			time.Duration(p.RequestCheckTimeoutSecs)*time.Second,
+			time.Duration(p.DelayIntervalMilliSecs)*time.Millisecond,
+		),
+		WithMaxNumberOfRetries(p.MaxNumberOfRetries),
+	}
+}
+
+//validate returns all problems of a profile. Missing credentials are not a problem here,
+//as they can be set by environment variables.
+func (p Profile) validate() []string {
+	var problems []string
+	if p.APIURL != "" {
+		u, err := url.Parse(p.APIURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("'api_url' %q is not a valid http(s) URL", p.APIURL))
+		}
+	}
+	if p.UserUUID != "" && !isValidUUID(p.UserUUID) {
+		problems = append(problems, fmt.Sprintf("'user_uuid' %q is not a valid UUID", p.UserUUID))
+	}
+	if p.LocationUUID != "" && !isValidUUID(p.LocationUUID) {
+		problems = append(problems, fmt.Sprintf("'location_uuid' %q is not a valid UUID", p.LocationUUID))
+	}
+	if p.RequestCheckTimeoutSecs < 0 {
+		problems = append(problems, "'request_check_timeout_secs' must not be negative")
+	}
+	if p.DelayIntervalMilliSecs < 0 {
+		problems = append(problems, "'delay_interval_millisecs' must not be negative")
+	}
+	if p.MaxNumberOfRetries < 0 {
+		problems = append(problems, "'max_number_of_retries' must not be negative")
+	}
+	return problems
+}
@@ -0,0 +1,128 @@
+package gsclient
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testProfilesYAML = `
+default_profile: dev
+profiles:
+  dev:
+    user_uuid: 690de890-13c0-4e76-8a01-e10ba8786e53
+    api_token: dev-token
+    location_uuid: 45ed677b-3702-4b36-be2a-a2eab9827950
+  prod:
+    api_url: https://api.example.com
+    user_uuid: 0bd6a6f3-23a9-4b0a-8a51-7ad1e3ba1a45
+    api_token: prod-token
+    sync: true
+    request_check_timeout_secs: 300
+    delay_interval_millisecs: 1000
+    max_number_of_retries: 3
+  tokenless:
+    user_uuid: 690de890-13c0-4e76-8a01-e10ba8786e53
+`
+
+const testProfilesJSON = `{
+	"profiles": {
+		"default": {
+			"user_uuid": "690de890-13c0-4e76-8a01-e10ba8786e53",
+			"api_token": "json-token"
+		}
+	}
+}`
+
+func writeProfileFile(t *testing.T, name, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "gsclient")
+	assert.Nil(t, err)
+	path := filepath.Join(dir, name)
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestConfigFromProfile(t *testing.T) {
+	path, cleanup := writeProfileFile(t, "config.yaml", testProfilesYAML)
+	defer cleanup()
+
+	cfg, err := ConfigFromProfile(path, "")
+	assert.Nil(t, err, "ConfigFromProfile returned an error %v", err)
+	assert.Equal(t, defaultAPIURL, cfg.apiURL)
+	assert.Equal(t, "690de890-13c0-4e76-8a01-e10ba8786e53", cfg.userUUID)
+	assert.Equal(t, "dev-token", cfg.apiToken)
+	assert.Equal(t, "45ed677b-3702-4b36-be2a-a2eab9827950", cfg.locationUUID)
+	assert.False(t, cfg.sync)
+
+	cfg, err = ConfigFromProfile(path, "prod")
+	assert.Nil(t, err, "ConfigFromProfile returned an error %v", err)
+	assert.Equal(t, "https://api.example.com", cfg.apiURL)
+	assert.Equal(t, "prod-token", cfg.apiToken)
+	assert.True(t, cfg.sync)
+	assert.Equal(t, 300*time.Second, cfg.requestCheckTimeoutSecs)
+	assert.Equal(t, time.Second, cfg.delayInterval)
+	assert.Equal(t, 3, cfg.retryPolicy.(*ExponentialBackoffRetryPolicy).MaxRetries)
+
+	_, err = ConfigFromProfile(path, "staging")
+	assert.NotNil(t, err)
+
+	//the token can be provided by the environment
+	_, err = ConfigFromProfile(path, "tokenless")
+	assert.NotNil(t, err)
+	restore := setEnv(map[string]string{envToken: "env-token", envSync: "true", envProfile: "tokenless"})
+	cfg, err = ConfigFromProfile(path, "")
+	restore()
+	assert.Nil(t, err, "ConfigFromProfile returned an error %v", err)
+	assert.Equal(t, "env-token", cfg.apiToken)
+	assert.True(t, cfg.sync)
+
+	//options take precedence over the environment and the profile
+	cfg, err = ConfigFromProfile(path, "prod", WithSync(false))
+	assert.Nil(t, err, "ConfigFromProfile returned an error %v", err)
+	assert.False(t, cfg.sync)
+}
+
+func TestConfigFromProfileJSON(t *testing.T) {
+	path, cleanup := writeProfileFile(t, "config.json", testProfilesJSON)
+	defer cleanup()
+	cfg, err := ConfigFromProfile(path, "")
+	assert.Nil(t, err, "ConfigFromProfile returned an error %v", err)
+	assert.Equal(t, "json-token", cfg.apiToken)
+}
+
+func TestLoadProfilesInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"malformed":       "profiles: [",
+		"unknown field":   "profiles:\n  dev:\n    user_uid: 690de890-13c0-4e76-8a01-e10ba8786e53\n",
+		"no profiles":     "default_profile: dev\n",
+		"missing default": "default_profile: prod\nprofiles:\n  dev:\n    api_token: token\n",
+		"invalid values": `profiles:
+  dev:
+    api_url: api.gridscale.io
+    user_uuid: abc-123
+    location_uuid: xyz
+    request_check_timeout_secs: -1
+`,
+	}
+	for name, content := range testCases {
+		path, cleanup := writeProfileFile(t, "config.yaml", content)
+		_, err := LoadProfiles(path)
+		cleanup()
+		assert.NotNil(t, err, name)
+	}
+
+	path, cleanup := writeProfileFile(t, "config.yaml", testCases["invalid values"])
+	defer cleanup()
+	_, err := LoadProfiles(path)
+	//all problems are reported at once
+	for _, field := range []string{"api_url", "user_uuid", "location_uuid", "request_check_timeout_secs"} {
+		assert.Contains(t, err.Error(), field)
+	}
+
+	_, err = LoadProfiles(filepath.Join(os.TempDir(), "does-not-exist.yaml"))
+	assert.NotNil(t, err)
+}
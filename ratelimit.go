@@ -0,0 +1,128 @@
+package gsclient
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//RateLimiter limits the rate of requests a client sends to the API.
+//
+//A limiter is shared by all requests of a client, so implementations must be safe for concurrent use.
+type RateLimiter interface {
+	//Wait blocks until the next request may be sent or the context is done
+	Wait(ctx context.Context) error
+
+	//Update adapts the limiter to the rate limit information of a response
+	Update(resp *http.Response)
+}
+
+//TokenBucketRateLimiter is a token bucket rate limiter. It additionally honors the `X-RateLimit-Remaining` and
+//`X-RateLimit-Reset` headers returned by the API: once the API reports that no request is remaining, all requests
+//are held back until the reported reset time.
+type TokenBucketRateLimiter struct {
+	mu           sync.Mutex
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+}
+
+//NewTokenBucketRateLimiter creates a new token bucket rate limiter
+//
+//- Parameters:
+//		+ ratePerSecond float64: number of requests per second. 0 => the rate is only limited by the API's headers.
+//		+ burst int: maximum number of requests that can be sent at once. 0 => 1.
+func NewTokenBucketRateLimiter(ratePerSecond float64, burst int) *TokenBucketRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucketRateLimiter{
+		rate:   ratePerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+//Wait implements RateLimiter
+func (l *TokenBucketRateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := l.reserve(time.Now())
+		if wait <= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+//reserve takes a token if one is available, otherwise it returns how long to wait for the next one
+func (l *TokenBucketRateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+//Update implements RateLimiter
+func (l *TokenBucketRateLimiter) Update(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	hasRemaining := err == nil
+	if !hasRemaining && resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if hasRemaining && float64(remaining) < l.tokens {
+		l.tokens = float64(remaining)
+	}
+	if remaining <= 0 || resp.StatusCode == http.StatusTooManyRequests {
+		if reset, ok := rateLimitReset(resp); ok && reset.After(l.blockedUntil) {
+			l.blockedUntil = reset
+		}
+	}
+}
+
+//rateLimitReset reads the `X-RateLimit-Reset` header of a response. The header can either be a unix timestamp
+//(in seconds or milliseconds) or a number of seconds until the reset.
+func rateLimitReset(resp *http.Response) (time.Time, bool) {
+	if resp == nil {
+		return time.Time{}, false
+	}
+	value, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || value < 0 {
+		return time.Time{}, false
+	}
+	switch {
+	case value >= 1e12:
+		return time.Unix(0, value*int64(time.Millisecond)), true
+	case value >= 1e9:
+		return time.Unix(value, 0), true
+	default:
+		return time.Now().Add(time.Duration(value) * time.Second), true
+	}
+}
@@ -0,0 +1,97 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketRateLimiter_Wait(t *testing.T) {
+	limiter := NewTokenBucketRateLimiter(20, 2)
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, limiter.Wait(emptyCtx))
+		}()
+	}
+	wg.Wait()
+	//2 requests are sent at once, the other 4 have to wait for 50ms each
+	elapsed := time.Since(start)
+	assert.True(t, elapsed >= 180*time.Millisecond, "requests were sent too fast: %v", elapsed)
+	assert.True(t, elapsed < time.Second, "requests were sent too slow: %v", elapsed)
+}
+
+func TestTokenBucketRateLimiter_WaitContextCancelled(t *testing.T) {
+	limiter := NewTokenBucketRateLimiter(0.1, 1)
+	assert.Nil(t, limiter.Wait(emptyCtx))
+	ctx, cancel := context.WithTimeout(emptyCtx, 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx))
+}
+
+func TestTokenBucketRateLimiter_Update(t *testing.T) {
+	type testCase struct {
+		statusCode int
+		remaining  string
+		reset      string
+		isBlocked  bool
+	}
+	inOneSec := strconv.FormatInt(time.Now().Add(time.Second).UnixNano()/int64(time.Millisecond), 10)
+	testCases := []testCase{
+		{http.StatusOK, "", "", false},
+		{http.StatusOK, "10", inOneSec, false},
+		{http.StatusOK, "0", inOneSec, true},
+		{http.StatusOK, "0", "1", true},
+		{http.StatusTooManyRequests, "", "1", true},
+		{http.StatusTooManyRequests, "", "", false},
+	}
+	for i, test := range testCases {
+		limiter := NewTokenBucketRateLimiter(0, 1)
+		resp := &http.Response{StatusCode: test.statusCode, Header: http.Header{}}
+		if test.remaining != "" {
+			resp.Header.Set("X-RateLimit-Remaining", test.remaining)
+		}
+		if test.reset != "" {
+			resp.Header.Set("X-RateLimit-Reset", test.reset)
+		}
+		limiter.Update(resp)
+		wait := limiter.reserve(time.Now())
+		if test.isBlocked {
+			assert.True(t, wait > 500*time.Millisecond && wait <= time.Second, "test case %d: unexpected wait %v", i, wait)
+		} else {
+			assert.Equal(t, time.Duration(0), wait, "test case %d", i)
+		}
+	}
+}
+
+func TestRequest_executeTooManyRequests(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	client.cfg.SetRateLimiter(NewTokenBucketRateLimiter(100, 10))
+	var calls int32
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			writer.Header().Set("X-RateLimit-Remaining", "0")
+			writer.Header().Set("X-RateLimit-Reset", "1")
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(writer, prepareServerListHTTPGet("active"))
+	})
+	start := time.Now()
+	res, err := client.GetServerList(emptyCtx)
+	assert.Nil(t, err, "GetServerList returned an error %v", err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.True(t, time.Since(start) >= 900*time.Millisecond, "request was retried too early: %v", time.Since(start))
+}
@@ -2,18 +2,28 @@ package gsclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
+//requestUUIDHeader is the response header containing the UUID of a request
+const requestUUIDHeader = "X-Request-Id"
+
 //Request gridscale's custom request struct
 type Request struct {
 	uri          string
 	method       string
 	skipPrint404 bool
 	body         interface{}
+
+	//UUID of the request as returned by the server, set by execute
+	requestUUID string
 }
 
 //CreateResponse common struct of a response for creation
@@ -35,11 +45,24 @@ type RequestStatusProperties struct {
 	CreateTime GSTime `json:"create_time"`
 }
 
-//RequestError error of a request
+//RequestError error of a request. It matches ErrRequestFailed, and depending on its status code ErrNotFound or
+//ErrConflict, when checked with errors.Is.
 type RequestError struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	StatusCode  int
+
+	//HTTP method of the failed request
+	Method string `json:"-"`
+
+	//URI of the failed request, relative to the API URL
+	URI string `json:"-"`
+
+	//UUID of the request, if the server returned one
+	RequestUUID string `json:"-"`
+
+	//Number of attempts made before giving up
+	Attempts int `json:"-"`
 }
 
 //Error just returns error as string
@@ -48,13 +71,53 @@ func (r RequestError) Error() string {
 	if message == "" {
 		message = "no error message received from server"
 	}
-	return fmt.Sprintf("statuscode %v returned: %s", r.StatusCode, message)
+	if r.Method == "" {
+		return fmt.Sprintf("statuscode %v returned: %s", r.StatusCode, message)
+	}
+	return fmt.Sprintf("statuscode %v returned: %s (%s %s)", r.StatusCode, message, r.Method, r.URI)
 }
 
-//This function takes the client and a struct and then adds the result to the given struct if possible
-func (r *Request) execute(c Client, output interface{}) error {
-	url := c.cfg.apiURL + r.uri
-	c.cfg.logger.Debugf("%v request sent to URL: %v", r.method, url)
+//Is reports whether the request error matches one of the sentinel errors
+func (r RequestError) Is(target error) bool {
+	switch target {
+	case ErrRequestFailed:
+		return true
+	case ErrNotFound:
+		return r.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return r.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
+//This function takes the client and a struct and then adds the result to the given struct if possible.
+//The request (including its retries) is cancelled when the context is done.
+func (r *Request) execute(ctx context.Context, c *Client, output interface{}) error {
+	return r.run(ctx, c, output, nil)
+}
+
+//stream sends the request like execute, but returns the body of a successful response without reading it,
+//so that it can be decoded incrementally. The caller has to close the body. Middlewares see an empty body
+//in the response, unless they return a response of their own.
+func (r *Request) stream(ctx context.Context, c *Client) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	err := r.run(ctx, c, nil, &body)
+	return body, err
+}
+
+//run sends the request. The response is decoded into output, or returned in body if body is set.
+func (r *Request) run(ctx context.Context, c *Client, output interface{}, body *io.ReadCloser) error {
+	//An invalid body is not sent at all
+	if validator, ok := r.body.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+	logFields := Fields{
+		"method": r.method,
+		"uri":    r.uri,
+	}
+	c.cfg.logger.Debug("Sending request", logFields.with(Fields{"url": c.cfg.apiURL + r.uri}))
 
 	//Convert the body of the request to json
 	jsonBody := new(bytes.Buffer)
@@ -66,55 +129,175 @@ func (r *Request) execute(c Client, output interface{}) error {
 	}
 
 	//Add authentication headers and content type
-	request, err := http.NewRequest(r.method, url, jsonBody)
-	if err != nil {
-		return err
-	}
-	request.Header.Set("User-Agent", c.cfg.userAgent)
-	request.Header.Add("X-Auth-UserID", c.cfg.userUUID)
-	request.Header.Add("X-Auth-Token", c.cfg.apiToken)
-	request.Header.Add("Content-Type", "application/json")
-	c.cfg.logger.Debugf("Request body: %v", request.Body)
-	return retryWithLimitedNumOfRetries(func() (bool, error) {
-		//execute the request
-		result, err := c.cfg.httpClient.Do(request)
-		if err != nil {
-			c.cfg.logger.Errorf("Error while executing the request: %v", err)
-			return false, err
-		}
+	header := make(http.Header)
+	header.Set("User-Agent", c.cfg.userAgent)
+	header.Add("X-Auth-UserID", c.cfg.userUUID)
+	header.Add("X-Auth-Token", c.cfg.apiToken)
+	header.Add("Content-Type", "application/json")
+	c.cfg.logger.Debug("Request body", logFields.with(Fields{"body": jsonBody.String()}))
 
-		iostream, err := ioutil.ReadAll(result.Body)
-		if err != nil {
-			c.cfg.logger.Errorf("Error while reading the response's body: %v", err)
-			return false, err
+	handler := c.send
+	if body != nil {
+		handler = func(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+			return c.sendRequest(ctx, req, func(streamed io.ReadCloser) {
+				if *body != nil {
+					(*body).Close()
+				}
+				*body = streamed
+			})
 		}
-
-		c.cfg.logger.Debugf("Status code returned: %v", result.StatusCode)
-
-		if result.StatusCode >= 300 {
-			var errorMessage RequestError //error messages have a different structure, so they are read with a different struct
-			errorMessage.StatusCode = result.StatusCode
-			json.Unmarshal(iostream, &errorMessage)
-			//If internal server error or object is in status that does not allow the request, retry
-			if result.StatusCode >= 500 || result.StatusCode == 424 {
-				return true, errorMessage
-			}
-			if r.skipPrint404 && result.StatusCode == 404 {
-				c.cfg.logger.Debug("Skip 404 error code.")
-				return false, errorMessage
+	}
+	if c.cfg.cache != nil {
+		handler = c.cfg.cache.middleware(handler)
+	}
+	for i := len(c.cfg.middlewares) - 1; i >= 0; i-- {
+		handler = c.cfg.middlewares[i](handler)
+	}
+	var requestUUID string
+	var attempts int
+	err := retryWithPolicy(ctx, func(attempt int) (*http.Response, error) {
+		attempts = attempt
+		//every attempt gets its own copy of the request, so middlewares can modify it
+		req := &MiddlewareRequest{
+			Method:  r.method,
+			URI:     r.uri,
+			Header:  cloneHeader(header),
+			Body:    jsonBody.Bytes(),
+			Attempt: attempt,
+		}
+		res := handler(ctx, req)
+		if res == nil {
+			return nil, errors.New("middleware returned no response")
+		}
+		if res.StatusCode == 0 {
+			if res.Err == nil {
+				return nil, errors.New("middleware returned no response")
 			}
-			c.cfg.logger.Errorf("Error message: %v. Title: %v. Code: %v.", errorMessage.Description, errorMessage.Title, errorMessage.StatusCode)
-			return false, errorMessage
+			return nil, res.Err
+		}
+		result := &http.Response{
+			StatusCode: res.StatusCode,
+			Header:     res.Header,
+		}
+		requestUUID = res.Header.Get(requestUUIDHeader)
+		r.requestUUID = requestUUID
+		if res.Err != nil {
+			return result, res.Err
+		}
+		//a middleware answered the request itself
+		if body != nil && *body == nil {
+			*body = ioutil.NopCloser(bytes.NewReader(res.Body))
 		}
-		c.cfg.logger.Debugf("Response body: %v", string(iostream))
 		//if output is set
 		if output != nil {
-			err = json.Unmarshal(iostream, output) //Edit the given struct
+			err := json.Unmarshal(res.Body, output) //Edit the given struct
 			if err != nil {
-				c.cfg.logger.Errorf("Error while marshaling JSON: %v", err)
-				return false, err
+				c.cfg.logger.Error("Error while unmarshaling JSON", logFields.with(Fields{
+					"status_code":  res.StatusCode,
+					"request_uuid": requestUUID,
+					"error":        err,
+				}))
+				return result, err
 			}
 		}
-		return false, nil
-	}, c.cfg.maxNumberOfRetries, c.cfg.delayInterval)
+		return result, nil
+	}, c.cfg.retryPolicy, c.cfg.logger, logFields)
+	if err != nil && body != nil && *body != nil {
+		(*body).Close()
+		*body = nil
+	}
+	if errorMessage, ok := err.(RequestError); ok {
+		errorMessage.Method = r.method
+		errorMessage.URI = r.uri
+		errorMessage.RequestUUID = requestUUID
+		errorMessage.Attempts = attempts
+		err = errorMessage
+		errorFields := logFields.with(Fields{
+			"status_code":  errorMessage.StatusCode,
+			"request_uuid": requestUUID,
+			"title":        errorMessage.Title,
+			"description":  errorMessage.Description,
+		})
+		if r.skipPrint404 && errorMessage.StatusCode == 404 {
+			c.cfg.logger.Debug("Skip 404 error code", errorFields)
+			return err
+		}
+		c.cfg.logger.Error("Request failed", errorFields)
+	}
+	return err
+}
+
+//send sends a single request to the API. It is the innermost RequestHandler of the middleware chain.
+func (c *Client) send(ctx context.Context, req *MiddlewareRequest) *MiddlewareResponse {
+	return c.sendRequest(ctx, req, nil)
+}
+
+//sendRequest sends a single request to the API. If stream is set, the body of a successful response is not read
+//but passed to stream, which has to close it.
+func (c *Client) sendRequest(ctx context.Context, req *MiddlewareRequest, stream func(io.ReadCloser)) *MiddlewareResponse {
+	logFields := Fields{
+		"method":  req.Method,
+		"uri":     req.URI,
+		"attempt": req.Attempt,
+	}
+	request, err := http.NewRequest(req.Method, c.cfg.apiURL+req.URI, bytes.NewReader(req.Body))
+	if err != nil {
+		return &MiddlewareResponse{Err: err}
+	}
+	request = request.WithContext(ctx)
+	request.Header = req.Header
+	if c.cfg.rateLimiter != nil {
+		err := c.cfg.rateLimiter.Wait(ctx)
+		if err != nil {
+			return &MiddlewareResponse{Err: err}
+		}
+	}
+	start := time.Now()
+	//execute the request
+	result, err := c.cfg.httpClient.Do(request)
+	if err != nil {
+		if ctx.Err() != nil {
+			//the request was aborted because the context is done
+			return &MiddlewareResponse{Err: ctx.Err(), Latency: time.Since(start)}
+		}
+		c.cfg.logger.Error("Error while executing the request", logFields.with(Fields{"error": err}))
+		return &MiddlewareResponse{Err: err, Latency: time.Since(start)}
+	}
+	if c.cfg.rateLimiter != nil {
+		c.cfg.rateLimiter.Update(result)
+	}
+	res := &MiddlewareResponse{
+		StatusCode: result.StatusCode,
+		Header:     result.Header,
+	}
+	responseFields := logFields.with(Fields{
+		"status_code":  result.StatusCode,
+		"request_uuid": result.Header.Get(requestUUIDHeader),
+	})
+	if stream != nil && result.StatusCode < 300 {
+		res.Latency = time.Since(start)
+		c.cfg.logger.Debug("Streaming response", responseFields.with(Fields{"latency": res.Latency}))
+		stream(result.Body)
+		return res
+	}
+	defer result.Body.Close()
+
+	res.Body, res.Err = ioutil.ReadAll(result.Body)
+	res.Latency = time.Since(start)
+	if res.Err != nil {
+		c.cfg.logger.Error("Error while reading the response's body", responseFields.with(Fields{"error": res.Err}))
+		return res
+	}
+
+	c.cfg.logger.Debug("Response received", responseFields.with(Fields{"latency": res.Latency}))
+
+	if result.StatusCode >= 300 {
+		var errorMessage RequestError //error messages have a different structure, so they are read with a different struct
+		errorMessage.StatusCode = result.StatusCode
+		json.Unmarshal(res.Body, &errorMessage)
+		res.Err = errorMessage
+		return res
+	}
+	c.cfg.logger.Debug("Response body", responseFields.with(Fields{"body": string(res.Body)}))
+	return res
 }
@@ -0,0 +1,78 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//closeCountingTransport counts how many response bodies have been closed
+type closeCountingTransport struct {
+	closed int32
+}
+
+type closeCountingBody struct {
+	io.ReadCloser
+	transport *closeCountingTransport
+}
+
+func (b closeCountingBody) Close() error {
+	atomic.AddInt32(&b.transport.closed, 1)
+	return b.ReadCloser.Close()
+}
+
+func (t *closeCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = closeCountingBody{resp.Body, t}
+	return resp, nil
+}
+
+func TestRequest_executeReplayBody(t *testing.T) {
+	failuresTestCases := []int{0, 1, 3}
+	for _, failures := range failuresTestCases {
+		server, client, mux := setupTestClient(false)
+		transport := &closeCountingTransport{}
+		client.cfg.httpClient = &http.Client{Transport: transport}
+		client.cfg.SetRetryPolicy(&ExponentialBackoffRetryPolicy{MaxRetries: 5})
+		var calls int32
+		var bodies []ServerCreateRequest
+		mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodPost, request.Method)
+			payload, err := ioutil.ReadAll(request.Body)
+			assert.Nil(t, err)
+			var body ServerCreateRequest
+			assert.Nil(t, json.Unmarshal(payload, &body), "invalid payload %q", payload)
+			bodies = append(bodies, body)
+			if int(atomic.AddInt32(&calls, 1)) <= failures {
+				writer.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprint(writer, prepareServerCreateResponse())
+		})
+		_, _, err := client.CreateServer(emptyCtx, ServerCreateRequest{
+			Name:         "test",
+			Memory:       10,
+			Cores:        4,
+			LocationUUID: dummyUUID,
+		})
+		assert.Nil(t, err, "CreateServer returned an error %v", err)
+		assert.Equal(t, failures+1, len(bodies))
+		for _, body := range bodies {
+			assert.Equal(t, "test", body.Name)
+			assert.Equal(t, 10, body.Memory)
+			assert.Equal(t, 4, body.Cores)
+			assert.Equal(t, dummyUUID, body.LocationUUID)
+		}
+		assert.Equal(t, int32(failures+1), atomic.LoadInt32(&transport.closed))
+		server.Close()
+	}
+}
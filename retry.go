@@ -0,0 +1,126 @@
+package gsclient
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+//RetryPolicy decides whether a failed request is sent again and how long to wait before doing so.
+//
+//A policy is shared by all requests of a client, so implementations must be safe for concurrent use.
+type RetryPolicy interface {
+	//ShouldRetry reports whether the request should be sent again after the given failed attempt (starting at 1).
+	//resp is nil if no response has been received, e.g. when the connection could not be established.
+	ShouldRetry(attempt int, resp *http.Response, err error) bool
+
+	//Delay returns how long to wait before the next attempt.
+	Delay(attempt int, resp *http.Response) time.Duration
+}
+
+//ExponentialBackoffRetryPolicy retries requests with an exponentially growing, fully jittered delay.
+//
+//The delay before the n-th retry is a random duration between 0 and min(MaxDelay, BaseDelay * 2^(n-1)).
+//If the server sends a `Retry-After` header, its value is used instead. If a 429 response has no `Retry-After` header,
+//the time until `X-RateLimit-Reset` is used.
+type ExponentialBackoffRetryPolicy struct {
+	//Maximum number of retries after the first attempt. 0 => requests are never retried.
+	MaxRetries int
+
+	//Base delay of the exponential backoff.
+	BaseDelay time.Duration
+
+	//Upper limit of the computed delay. 0 => no limit.
+	MaxDelay time.Duration
+
+	//Decides whether a response with the given status code is retried.
+	//nil => DefaultRetryableStatus is used.
+	RetryableStatus func(statusCode int) bool
+
+	//Decides whether a request that failed without a response (e.g. a transport error) is retried.
+	//nil => such requests are not retried.
+	RetryableError func(err error) bool
+}
+
+//DefaultRetryableStatus returns true for 5xx, 424 and 429 status codes. Those are returned when the server has an
+//internal error, when the object is in a status that does not allow the request (yet) or when the rate limit is reached.
+func DefaultRetryableStatus(statusCode int) bool {
+	return statusCode >= 500 || statusCode == http.StatusFailedDependency || statusCode == http.StatusTooManyRequests
+}
+
+//ShouldRetry implements RetryPolicy
+func (p *ExponentialBackoffRetryPolicy) ShouldRetry(attempt int, resp *http.Response, err error) bool {
+	if attempt > p.MaxRetries {
+		return false
+	}
+	if resp == nil {
+		return p.RetryableError != nil && p.RetryableError(err)
+	}
+	if p.RetryableStatus != nil {
+		return p.RetryableStatus(resp.StatusCode)
+	}
+	return DefaultRetryableStatus(resp.StatusCode)
+}
+
+//Delay implements RetryPolicy
+func (p *ExponentialBackoffRetryPolicy) Delay(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		return wait
+	}
+	backoff := p.MaxDelay
+	if backoff <= 0 {
+		backoff = math.MaxInt64
+	}
+	//avoid overflowing when shifting the base delay
+	if shift := uint(attempt - 1); shift < 63 && p.BaseDelay <= math.MaxInt64>>shift {
+		if d := p.BaseDelay << shift; d < backoff {
+			backoff = d
+		}
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	if backoff == math.MaxInt64 {
+		return time.Duration(rand.Int63())
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+//retryAfter reads the `Retry-After` header of a response. The header can either be a number of seconds or an HTTP date.
+//For 429 responses without that header, the rate limit's reset time is used.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		if resp.StatusCode != http.StatusTooManyRequests {
+			return 0, false
+		}
+		reset, ok := rateLimitReset(resp)
+		if !ok {
+			return 0, false
+		}
+		wait := time.Until(reset)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
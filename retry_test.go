@@ -0,0 +1,134 @@
+package gsclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponentialBackoffRetryPolicy_ShouldRetry(t *testing.T) {
+	type testCase struct {
+		policy      ExponentialBackoffRetryPolicy
+		attempt     int
+		resp        *http.Response
+		err         error
+		shouldRetry bool
+	}
+	transportErr := errors.New("connection refused")
+	testCases := []testCase{
+		{ExponentialBackoffRetryPolicy{MaxRetries: 3}, 1, &http.Response{StatusCode: 500}, nil, true},
+		{ExponentialBackoffRetryPolicy{MaxRetries: 3}, 1, &http.Response{StatusCode: 424}, nil, true},
+		{ExponentialBackoffRetryPolicy{MaxRetries: 3}, 1, &http.Response{StatusCode: 400}, nil, false},
+		{ExponentialBackoffRetryPolicy{MaxRetries: 3}, 4, &http.Response{StatusCode: 500}, nil, false},
+		{ExponentialBackoffRetryPolicy{MaxRetries: 0}, 1, &http.Response{StatusCode: 500}, nil, false},
+		{ExponentialBackoffRetryPolicy{MaxRetries: 3}, 1, nil, transportErr, false},
+		{ExponentialBackoffRetryPolicy{
+			MaxRetries:     3,
+			RetryableError: func(err error) bool { return err == transportErr },
+		}, 1, nil, transportErr, true},
+		{ExponentialBackoffRetryPolicy{
+			MaxRetries:      3,
+			RetryableStatus: func(statusCode int) bool { return statusCode == 409 },
+		}, 1, &http.Response{StatusCode: 409}, nil, true},
+		{ExponentialBackoffRetryPolicy{
+			MaxRetries:      3,
+			RetryableStatus: func(statusCode int) bool { return statusCode == 409 },
+		}, 1, &http.Response{StatusCode: 500}, nil, false},
+	}
+	for i, test := range testCases {
+		assert.Equal(t, test.shouldRetry, test.policy.ShouldRetry(test.attempt, test.resp, test.err), "test case %d", i)
+	}
+}
+
+func TestExponentialBackoffRetryPolicy_Delay(t *testing.T) {
+	policy := ExponentialBackoffRetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+	for attempt := 1; attempt <= 100; attempt++ {
+		limit := policy.MaxDelay
+		if attempt <= 4 {
+			limit = policy.BaseDelay << uint(attempt-1)
+		}
+		for i := 0; i < 10; i++ {
+			delay := policy.Delay(attempt, nil)
+			assert.True(t, delay >= 0 && delay <= limit, "attempt %d: delay %v not within [0, %v]", attempt, delay, limit)
+		}
+	}
+}
+
+func TestExponentialBackoffRetryPolicy_DelayRetryAfter(t *testing.T) {
+	policy := ExponentialBackoffRetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, policy.Delay(1, resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	delay := policy.Delay(1, resp)
+	assert.True(t, delay > 58*time.Second && delay <= time.Minute, "unexpected delay %v", delay)
+
+	resp.Header.Set("Retry-After", "invalid")
+	assert.True(t, policy.Delay(1, resp) <= policy.BaseDelay)
+}
+
+func TestRequest_executeRetry(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	var calls int32
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			writer.Header().Set("Retry-After", "0")
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(writer, prepareServerListHTTPGet("active"))
+	})
+	start := time.Now()
+	res, err := client.GetServerList(emptyCtx)
+	assert.Nil(t, err, "GetServerList returned an error %v", err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	//the first attempt is not delayed and Retry-After: 0 means no delay between attempts
+	assert.True(t, time.Since(start) < client.cfg.delayInterval, "request took %v", time.Since(start))
+}
+
+func TestRequest_executeNoRetry(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	var calls int32
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client.cfg.SetRetryPolicy(&ExponentialBackoffRetryPolicy{MaxRetries: 0})
+	_, err := client.GetServerList(emptyCtx)
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRequest_executeNilRetryPolicy(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, prepareServerListHTTPGet("active"))
+	})
+	client.cfg.SetRetryPolicy(nil)
+	res, err := client.GetServerList(emptyCtx)
+	assert.Nil(t, err, "GetServerList returned an error %v", err)
+	assert.Equal(t, 1, len(res))
+
+	client = NewClient(NewConfig(WithAPIURL(server.URL), WithRetryPolicy(nil)))
+	res, err = client.GetServerList(emptyCtx)
+	assert.Nil(t, err, "GetServerList returned an error %v", err)
+	assert.Equal(t, 1, len(res))
+}
@@ -1,11 +1,32 @@
 package gsclient
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"path"
 )
 
+//ServerOperator is an interface defining API of a server operator
+type ServerOperator interface {
+	GetServer(ctx context.Context, id string) (Server, error)
+	GetServerList(ctx context.Context, opts ...ListOptions) ([]Server, error)
+	ServersIter(ctx context.Context, yield func(Server) bool) error
+	CreateServer(ctx context.Context, body ServerCreateRequest) (ServerCreateResponse, *Operation, error)
+	DeleteServer(ctx context.Context, id string) (*Operation, error)
+	UpdateServer(ctx context.Context, id string, body ServerUpdateRequest) (*Operation, error)
+	UpdateServerFunc(ctx context.Context, id string, mutate func(*ServerProperties) error) (*Operation, error)
+	GetServerEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+	GetServerMetricList(ctx context.Context, id string, opts ...ListOptions) ([]ServerMetric, error)
+	IsServerOn(ctx context.Context, id string) (bool, error)
+	StartServer(ctx context.Context, id string) (*Operation, error)
+	StopServer(ctx context.Context, id string) (*Operation, error)
+	ShutdownServer(ctx context.Context, id string) (*Operation, error)
+	GetServersByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Server, error)
+	GetDeletedServers(ctx context.Context, opts ...ListOptions) ([]Server, error)
+}
+
 //ServerList JSON struct of a list of servers
 type ServerList struct {
 	//Array of servers
@@ -139,6 +160,19 @@ type ServerCreateRequest struct {
 	Relations *ServerCreateRequestRelations `json:"relations,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r ServerCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.atLeast("memory", r.Memory, 1)
+	v.atLeast("cores", r.Cores, 1)
+	v.labels("labels", r.Labels)
+	if r.Relations != nil && len(r.Relations.Networks) > maxServerNetworks {
+		v.add("relations.networks", "has to contain at most %d private networks", maxServerNetworks)
+	}
+	return v.err()
+}
+
 //ServerCreateRequestRelations JSOn struct of a list of a server's relations
 type ServerCreateRequestRelations struct {
 	//Array of objects (ServerCreateRequestIsoimage)
@@ -216,25 +250,43 @@ type ServerCreateRequestIsoimage struct {
 type ServerUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
 	//Leave it if you do not want to update the name.
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//Defines which Availability-Zone the Server is placed. Leave it if you do not want to update the zone.
-	AvailablityZone string `json:"availability_zone,omitempty"`
+	AvailablityZone *string `json:"availability_zone,omitempty"`
 
 	//The amount of server memory in GB. Leave it if you do not want to update the memory
-	Memory int `json:"memory,omitempty"`
+	Memory *int `json:"memory,omitempty"`
 
 	//The number of server cores. Leave it if you do not want to update the number of the cpu cores.
-	Cores int `json:"cores,omitempty"`
+	Cores *int `json:"cores,omitempty"`
 
 	//List of labels. Leave it if you do not want to update the list of labels
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
 
 	//If the server should be auto-started in case of a failure (default=true).
 	//Leave it if you do not want to update this feature of the server.
 	AutoRecovery *bool `json:"auto_recovery,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r ServerUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Memory != nil {
+		v.atLeast("memory", *r.Memory, 1)
+	}
+	if r.Cores != nil {
+		v.atLeast("cores", *r.Cores, 1)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
+}
+
 //ServerMetricList JSON struct of a list of a server's metrics
 type ServerMetricList struct {
 	//Array of a server's metrics
@@ -292,36 +344,62 @@ var (
 //GetServer gets a specific server based on given list
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServer
-func (c *Client) GetServer(id string) (Server, error) {
+func (c *Client) GetServer(ctx context.Context, id string) (Server, error) {
 	if !isValidUUID(id) {
-		return Server{}, errors.New("'id' is invalid")
+		return Server{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id),
 		method: http.MethodGet,
 	}
 	var response Server
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //GetServerList gets a list of available servers
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServers
-func (c *Client) GetServerList() ([]Server, error) {
+func (c *Client) GetServerList(ctx context.Context, opts ...ListOptions) ([]Server, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiServerBase,
+		uri:    apiServerBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response ServerList
 	var servers []Server
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		servers = append(servers, Server{
 			Properties: properties,
 		})
 	}
-	return servers, err
+	return servers, options.apply(&servers)
+}
+
+//ServersIter calls yield for all servers, in the order of the response. The response is decoded one object at a time
+//instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) ServersIter(ctx context.Context, yield func(Server) bool) error {
+	return c.iterate(ctx, apiServerBase, "servers", func(key string, dec *json.Decoder) (bool, error) {
+		var server Server
+		if err := dec.Decode(&server.Properties); err != nil {
+			return false, err
+		}
+		if server.Properties.ObjectUUID == "" {
+			server.Properties.ObjectUUID = key
+		}
+		return yield(server), nil
+	})
 }
 
 //CreateServer create a server
@@ -330,7 +408,10 @@ func (c *Client) GetServerList() ([]Server, error) {
 //CiscoCSRServerHardware, SophosUTMServerHardware, F5BigipServerHardware, Q35ServerHardware, Q35NestedServerHardware.
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createServer
-func (c *Client) CreateServer(body ServerCreateRequest) (ServerCreateResponse, error) {
+func (c *Client) CreateServer(ctx context.Context, body ServerCreateRequest) (ServerCreateResponse, *Operation, error) {
+	if body.LocationUUID == "" {
+		body.LocationUUID = c.cfg.locationUUID
+	}
 	//check if these slices are nil
 	//make them be empty slice instead of nil
 	//so that JSON structure will be valid
@@ -352,12 +433,9 @@ func (c *Client) CreateServer(body ServerCreateRequest) (ServerCreateResponse, e
 		body:   body,
 	}
 	var response ServerCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return ServerCreateResponse{}, err
-	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
+		return ServerCreateResponse{}, nil, err
 	}
 	//this fixed the endpoint's bug temporarily when creating server with/without
 	//'relations' field
@@ -366,97 +444,132 @@ func (c *Client) CreateServer(body ServerCreateRequest) (ServerCreateResponse, e
 	} else if response.ObjectUUID == "" && response.ServerUUID != "" {
 		response.ObjectUUID = response.ServerUUID
 	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "server", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //DeleteServer deletes a specific server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteServer
-func (c *Client) DeleteServer(id string) error {
+func (c *Client) DeleteServer(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForServerDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "server", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerDeleted(ctx, id)
+	})
 }
 
 //UpdateServer updates a specific server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateServer
-func (c *Client) UpdateServer(id string, body ServerUpdateRequest) error {
+func (c *Client) UpdateServer(ctx context.Context, id string, body ServerUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForServerActive(id)
-	}
-	return r.execute(*c, nil)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.startOperation(ctx, OperationUpdate, "server", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerActive(ctx, id)
+	})
+}
+
+//UpdateServerFunc updates a server by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateServerFunc(ctx context.Context, id string, mutate func(*ServerProperties) error) (*Operation, error) {
+	var body ServerUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "server",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetServer(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*ServerProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateServer(ctx, id, body)
+		},
+	})
 }
 
 //GetServerEventList gets a list of a specific server's events
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerEvents
-func (c *Client) GetServerEventList(id string) ([]Event, error) {
+func (c *Client) GetServerEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiServerBase, id, "events"),
+		uri:    path.Join(apiServerBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var serverEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		serverEvents = append(serverEvents, Event{Properties: properties})
 	}
-	return serverEvents, err
+	return serverEvents, options.apply(&serverEvents)
 }
 
 //GetServerMetricList gets a list of a specific server's metrics
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerMetrics
-func (c *Client) GetServerMetricList(id string) ([]ServerMetric, error) {
+func (c *Client) GetServerMetricList(ctx context.Context, id string, opts ...ListOptions) ([]ServerMetric, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiServerBase, id, "metrics"),
+		uri:    path.Join(apiServerBase, id, "metrics") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ServerMetricList
 	var serverMetrics []ServerMetric
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		serverMetrics = append(serverMetrics, ServerMetric{Properties: properties})
 	}
-	return serverMetrics, err
+	return serverMetrics, options.apply(&serverMetrics)
 }
 
 //IsServerOn returns true if the server's power is on, otherwise returns false
-func (c *Client) IsServerOn(id string) (bool, error) {
-	server, err := c.GetServer(id)
+func (c *Client) IsServerOn(ctx context.Context, id string) (bool, error) {
+	server, err := c.GetServer(ctx, id)
 	if err != nil {
 		return false, err
 	}
@@ -465,13 +578,17 @@ func (c *Client) IsServerOn(id string) (bool, error) {
 
 //setServerPowerState turn on/off a specific server.
 //turnOn=true to turn on, turnOn=false to turn off
-func (c *Client) setServerPowerState(id string, powerState bool) error {
-	isOn, err := c.IsServerOn(id)
+func (c *Client) setServerPowerState(ctx context.Context, id string, powerState bool) (*Operation, error) {
+	kind := OperationPowerOff
+	if powerState {
+		kind = OperationPowerOn
+	}
+	isOn, err := c.IsServerOn(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if isOn == powerState {
-		return nil
+		return newOperation(kind, "server", id, "", nil), nil
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id, "power"),
@@ -480,35 +597,35 @@ func (c *Client) setServerPowerState(id string, powerState bool) error {
 			Power: powerState,
 		},
 	}
-	err = r.execute(*c, nil)
+	err = r.execute(ctx, c, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if c.cfg.sync {
-		return c.waitForServerPowerStatus(id, powerState)
-	}
-	return nil
+	return c.startOperation(ctx, kind, "server", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerPowerStatus(ctx, id, powerState)
+	})
 }
 
 //StartServer starts a server
-func (c *Client) StartServer(id string) error {
-	return c.setServerPowerState(id, true)
+func (c *Client) StartServer(ctx context.Context, id string) (*Operation, error) {
+	return c.setServerPowerState(ctx, id, true)
 }
 
 //StopServer stops a server
-func (c *Client) StopServer(id string) error {
-	return c.setServerPowerState(id, false)
+func (c *Client) StopServer(ctx context.Context, id string) (*Operation, error) {
+	return c.setServerPowerState(ctx, id, false)
 }
 
-//ShutdownServer shutdowns a specific server
-func (c *Client) ShutdownServer(id string) error {
+//ShutdownServer shutdowns a specific server.
+//If the graceful shutdown fails, the server is powered off instead.
+func (c *Client) ShutdownServer(ctx context.Context, id string) (*Operation, error) {
 	//Make sure the server exists and that it isn't already in the state we need it to be
-	server, err := c.GetServer(id)
+	server, err := c.GetServer(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !server.Properties.Power {
-		return nil
+		return newOperation(OperationShutdown, "server", id, "", nil), nil
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id, "shutdown"),
@@ -516,87 +633,112 @@ func (c *Client) ShutdownServer(id string) error {
 		body:   map[string]string{},
 	}
 
-	err = r.execute(*c, nil)
+	err = r.execute(ctx, c, nil)
 	if err != nil {
-		if requestError, ok := err.(RequestError); ok {
-			if requestError.StatusCode == 500 {
-				c.cfg.logger.Debugf("Graceful shutdown for server %s has failed. power-off will be used", id)
-				return c.StopServer(id)
-			}
+		var requestError RequestError
+		if errors.As(err, &requestError) && requestError.StatusCode == http.StatusInternalServerError {
+			c.cfg.logger.Debug("Graceful shutdown has failed, power-off will be used", Fields{"server_uuid": id})
+			return c.StopServer(ctx, id)
 		}
-		return err
+		return nil, err
 	}
 
-	if c.cfg.sync {
+	return c.startOperation(ctx, OperationShutdown, "server", id, r.requestUUID, func(ctx context.Context) error {
 		//If we get an error, which includes a timeout, power off the server instead
-		err = c.waitForServerPowerStatus(id, false)
+		err := c.waitForServerPowerStatus(ctx, id, false)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		c.cfg.logger.Debug("Graceful shutdown has failed, power-off will be used", Fields{"server_uuid": id})
+		op, err := c.StopServer(ctx, id)
 		if err != nil {
-			c.cfg.logger.Debugf("Graceful shutdown for server %s has failed. power-off will be used", id)
-			return c.StopServer(id)
+			return err
 		}
-	}
-	return nil
+		return op.Wait(ctx)
+	})
 }
 
 //GetServersByLocation gets a list of servers by location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocationServers
-func (c *Client) GetServersByLocation(id string) ([]Server, error) {
+func (c *Client) GetServersByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Server, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLocationBase, id, "servers"),
+		uri:    path.Join(apiLocationBase, id, "servers") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ServerList
 	var servers []Server
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		servers = append(servers, Server{Properties: properties})
 	}
-	return servers, err
+	return servers, options.apply(&servers)
 }
 
 //GetDeletedServers gets a list of deleted servers
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedServers
-func (c *Client) GetDeletedServers() ([]Server, error) {
+func (c *Client) GetDeletedServers(ctx context.Context, opts ...ListOptions) ([]Server, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "servers"),
+		uri:    path.Join(apiDeletedBase, "servers") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedServerList
 	var servers []Server
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		servers = append(servers, Server{Properties: properties})
 	}
-	return servers, err
+	return servers, options.apply(&servers)
 }
 
 //waitForServerPowerStatus  allows to wait for a server changing its power status.
-func (c *Client) waitForServerPowerStatus(id string, status bool) error {
-	return retryWithTimeout(func() (bool, error) {
-		server, err := c.GetServer(id)
-		return server.Properties.Power != status, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForServerPowerStatus(ctx context.Context, id string, status bool) error {
+	condition := ConditionPoweredOff
+	if status {
+		condition = ConditionPoweredOn
+	}
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetServer(ctx, id)
+	}, condition, c.waitOptions())
 }
 
 //waitForServerActive allows to wait until the server's status is active
-func (c *Client) waitForServerActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		server, err := c.GetServer(id)
-		return server.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForServerActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetServer(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForServerDeleted allows to wait until the server is deleted
-func (c *Client) waitForServerDeleted(id string) error {
+func (c *Client) waitForServerDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiServerBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
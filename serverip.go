@@ -1,11 +1,21 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 )
 
+//ServerIPRelationOperator is an interface defining API of a server-IP relation operator
+type ServerIPRelationOperator interface {
+	GetServerIPList(ctx context.Context, id string, opts ...ListOptions) ([]ServerIPRelationProperties, error)
+	GetServerIP(ctx context.Context, serverID, ipID string) (ServerIPRelationProperties, error)
+	CreateServerIP(ctx context.Context, id string, body ServerIPRelationCreateRequest) (*Operation, error)
+	DeleteServerIP(ctx context.Context, serverID, ipID string) (*Operation, error)
+	LinkIP(ctx context.Context, serverID string, ipID string) (*Operation, error)
+	UnlinkIP(ctx context.Context, serverID string, ipID string) (*Operation, error)
+}
+
 //ServerIPRelationList JSON struct of a list of relations between a server and IP addresses
 type ServerIPRelationList struct {
 	//Array of relations between a server and IP addresses
@@ -45,110 +55,123 @@ type ServerIPRelationCreateRequest struct {
 	ObjectUUID string `json:"object_uuid"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r ServerIPRelationCreateRequest) Validate() error {
+	var v validator
+	v.required("object_uuid", r.ObjectUUID)
+	return v.err()
+}
+
 //GetServerIPList gets a list of a specific server's IPs
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedIps
-func (c *Client) GetServerIPList(id string) ([]ServerIPRelationProperties, error) {
+func (c *Client) GetServerIPList(ctx context.Context, id string, opts ...ListOptions) ([]ServerIPRelationProperties, error) {
 	if id == "" {
-		return nil, errors.New("'id' is required")
+		return nil, newArgumentError("'id' is required", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiServerBase, id, "ips"),
+		uri:    path.Join(apiServerBase, id, "ips") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ServerIPRelationList
-	err := r.execute(*c, &response)
-	return response.List, err
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	relations := response.List
+	return relations, options.apply(&relations)
 }
 
 //GetServerIP gets an IP of a specific server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedIp
-func (c *Client) GetServerIP(serverID, ipID string) (ServerIPRelationProperties, error) {
+func (c *Client) GetServerIP(ctx context.Context, serverID, ipID string) (ServerIPRelationProperties, error) {
 	if serverID == "" || ipID == "" {
-		return ServerIPRelationProperties{}, errors.New("'serverID' and 'ipID' are required")
+		return ServerIPRelationProperties{}, newArgumentError("'serverID' and 'ipID' are required", "serverID", "ipID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "ips", ipID),
 		method: http.MethodGet,
 	}
 	var response ServerIPRelation
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response.Properties, err
 }
 
 //CreateServerIP create a link between a server and an IP
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/linkIpToServer
-func (c *Client) CreateServerIP(id string, body ServerIPRelationCreateRequest) error {
+func (c *Client) CreateServerIP(ctx context.Context, id string, body ServerIPRelationCreateRequest) (*Operation, error) {
 	if id == "" || body.ObjectUUID == "" {
-		return errors.New("'server_id' and 'ip_id' are required")
+		return nil, newArgumentError("'server_id' and 'ip_id' are required", "server_id", "ip_id")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id, "ips"),
 		method: http.MethodPost,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerIPRelCreation(id, body.ObjectUUID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationCreate, "server_ip", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerIPRelCreation(ctx, id, body.ObjectUUID)
+	})
 }
 
 //DeleteServerIP delete a link between a server and an IP
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/unlinkIpFromServer
-func (c *Client) DeleteServerIP(serverID, ipID string) error {
+func (c *Client) DeleteServerIP(ctx context.Context, serverID, ipID string) (*Operation, error) {
 	if serverID == "" || ipID == "" {
-		return errors.New("'serverID' and 'ipID' are required")
+		return nil, newArgumentError("'serverID' and 'ipID' are required", "serverID", "ipID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "ips", ipID),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerIPRelDeleted(serverID, ipID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "server_ip", serverID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerIPRelDeleted(ctx, serverID, ipID)
+	})
 }
 
 //LinkIP attaches an IP to a server
-func (c *Client) LinkIP(serverID string, ipID string) error {
+func (c *Client) LinkIP(ctx context.Context, serverID string, ipID string) (*Operation, error) {
 	body := ServerIPRelationCreateRequest{
 		ObjectUUID: ipID,
 	}
-	return c.CreateServerIP(serverID, body)
+	return c.CreateServerIP(ctx, serverID, body)
 }
 
 //UnlinkIP removes a link between an IP and a server
-func (c *Client) UnlinkIP(serverID string, ipID string) error {
-	return c.DeleteServerIP(serverID, ipID)
+func (c *Client) UnlinkIP(ctx context.Context, serverID string, ipID string) (*Operation, error) {
+	return c.DeleteServerIP(ctx, serverID, ipID)
 }
 
 //waitForServerIPRelCreation allows to wait until the relation between a server and an IP address is created
-func (c *Client) waitForServerIPRelCreation(serverID, ipID string) error {
+func (c *Client) waitForServerIPRelCreation(ctx context.Context, serverID, ipID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(ipID) {
-		return errors.New("'serverID' and 'ipID' are required")
+		return newArgumentError("'serverID' and 'ipID' are required", "serverID", "ipID")
 	}
 	uri := path.Join(apiServerBase, serverID, "ips", ipID)
 	method := http.MethodGet
-	return c.waitFor200Status(uri, method)
+	return c.waitFor200Status(ctx, uri, method)
 }
 
 //waitForServerIPRelDeleted allows to wait until the relation between a server and an IP address is deleted
-func (c *Client) waitForServerIPRelDeleted(serverID, ipID string) error {
+func (c *Client) waitForServerIPRelDeleted(ctx context.Context, serverID, ipID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(ipID) {
-		return errors.New("'serverID' and 'ipID' are required")
+		return newArgumentError("'serverID' and 'ipID' are required", "serverID", "ipID")
 	}
 	uri := path.Join(apiServerBase, serverID, "ips", ipID)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -1,11 +1,22 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 )
 
+//ServerIsoImageRelationOperator is an interface defining API of a server-ISO-image relation operator
+type ServerIsoImageRelationOperator interface {
+	GetServerIsoImageList(ctx context.Context, id string, opts ...ListOptions) ([]ServerIsoImageRelationProperties, error)
+	GetServerIsoImage(ctx context.Context, serverID, isoImageID string) (ServerIsoImageRelationProperties, error)
+	UpdateServerIsoImage(ctx context.Context, serverID, isoImageID string, body ServerIsoImageRelationUpdateRequest) (*Operation, error)
+	CreateServerIsoImage(ctx context.Context, id string, body ServerIsoImageRelationCreateRequest) (*Operation, error)
+	DeleteServerIsoImage(ctx context.Context, serverID, isoImageID string) (*Operation, error)
+	LinkIsoImage(ctx context.Context, serverID string, isoimageID string) (*Operation, error)
+	UnlinkIsoImage(ctx context.Context, serverID string, isoimageID string) (*Operation, error)
+}
+
 //ServerIsoImageRelationList JSON struct of a list of relations between a server and ISO-Images
 type ServerIsoImageRelationList struct {
 	//Array of relations between a server and ISO-Images
@@ -42,132 +53,160 @@ type ServerIsoImageRelationCreateRequest struct {
 	ObjectUUID string `json:"object_uuid"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r ServerIsoImageRelationCreateRequest) Validate() error {
+	var v validator
+	v.required("object_uuid", r.ObjectUUID)
+	return v.err()
+}
+
 //ServerIsoImageRelationUpdateRequest JSON struct of a request for updating a relation between a server and an ISO-Image
 type ServerIsoImageRelationUpdateRequest struct {
 	//Whether the server boots from this ISO-image or not.
-	BootDevice bool   `json:"bootdevice"`
-	Name       string `json:"name"`
+	BootDevice *bool `json:"bootdevice,omitempty"`
+
+	//Name of the ISO-image
+	Name *string `json:"name,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r ServerIsoImageRelationUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	return v.err()
 }
 
 //GetServerIsoImageList gets a list of a specific server's ISO images
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedIsoimages
-func (c *Client) GetServerIsoImageList(id string) ([]ServerIsoImageRelationProperties, error) {
+func (c *Client) GetServerIsoImageList(ctx context.Context, id string, opts ...ListOptions) ([]ServerIsoImageRelationProperties, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiServerBase, id, "isoimages"),
+		uri:    path.Join(apiServerBase, id, "isoimages") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ServerIsoImageRelationList
-	err := r.execute(*c, &response)
-	return response.List, err
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	relations := response.List
+	return relations, options.apply(&relations)
 }
 
 //GetServerIsoImage gets an ISO image of a specific server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedIsoimage
-func (c *Client) GetServerIsoImage(serverID, isoImageID string) (ServerIsoImageRelationProperties, error) {
+func (c *Client) GetServerIsoImage(ctx context.Context, serverID, isoImageID string) (ServerIsoImageRelationProperties, error) {
 	if !isValidUUID(serverID) || !isValidUUID(isoImageID) {
-		return ServerIsoImageRelationProperties{}, errors.New("'id' is invalid")
+		return ServerIsoImageRelationProperties{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "isoimages", isoImageID),
 		method: http.MethodGet,
 	}
 	var response ServerIsoImageRelation
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response.Properties, err
 }
 
 //UpdateServerIsoImage updates a link between a storage and an ISO image
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateServerLinkedIsoimage
-func (c *Client) UpdateServerIsoImage(serverID, isoImageID string, body ServerIsoImageRelationUpdateRequest) error {
+func (c *Client) UpdateServerIsoImage(ctx context.Context, serverID, isoImageID string, body ServerIsoImageRelationUpdateRequest) (*Operation, error) {
 	if !isValidUUID(serverID) || !isValidUUID(isoImageID) {
-		return errors.New("'serverID' or 'isoImageID' is invalid")
+		return nil, newArgumentError("'serverID' or 'isoImageID' is invalid", "serverID", "isoImageID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "isoimages", isoImageID),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	return r.execute(*c, nil)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newOperation(OperationUpdate, "server_isoimage", serverID, r.requestUUID, nil), nil
 }
 
 //CreateServerIsoImage creates a link between a server and an ISO image
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/linkIsoimageToServer
-func (c *Client) CreateServerIsoImage(id string, body ServerIsoImageRelationCreateRequest) error {
+func (c *Client) CreateServerIsoImage(ctx context.Context, id string, body ServerIsoImageRelationCreateRequest) (*Operation, error) {
 	if !isValidUUID(id) || !isValidUUID(body.ObjectUUID) {
-		return errors.New("'serverID' or 'isoImageID' is invalid")
+		return nil, newArgumentError("'serverID' or 'isoImageID' is invalid", "serverID", "isoImageID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id, "isoimages"),
 		method: http.MethodPost,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerISOImageRelCreation(id, body.ObjectUUID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationCreate, "server_isoimage", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerISOImageRelCreation(ctx, id, body.ObjectUUID)
+	})
 }
 
 //DeleteServerIsoImage deletes a link between an ISO image and a server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/unlinkIsoimageFromServer
-func (c *Client) DeleteServerIsoImage(serverID, isoImageID string) error {
+func (c *Client) DeleteServerIsoImage(ctx context.Context, serverID, isoImageID string) (*Operation, error) {
 	if !isValidUUID(serverID) || !isValidUUID(isoImageID) {
-		return errors.New("'serverID' or 'isoImageID' is invalid")
+		return nil, newArgumentError("'serverID' or 'isoImageID' is invalid", "serverID", "isoImageID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "isoimages", isoImageID),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerISOImageRelDeleted(serverID, isoImageID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "server_isoimage", serverID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerISOImageRelDeleted(ctx, serverID, isoImageID)
+	})
 }
 
 //LinkIsoImage attaches an ISO image to a server
-func (c *Client) LinkIsoImage(serverID string, isoimageID string) error {
+func (c *Client) LinkIsoImage(ctx context.Context, serverID string, isoimageID string) (*Operation, error) {
 	body := ServerIsoImageRelationCreateRequest{
 		ObjectUUID: isoimageID,
 	}
-	return c.CreateServerIsoImage(serverID, body)
+	return c.CreateServerIsoImage(ctx, serverID, body)
 }
 
 //UnlinkIsoImage removes the link between an ISO image and a server
-func (c *Client) UnlinkIsoImage(serverID string, isoimageID string) error {
-	return c.DeleteServerIsoImage(serverID, isoimageID)
+func (c *Client) UnlinkIsoImage(ctx context.Context, serverID string, isoimageID string) (*Operation, error) {
+	return c.DeleteServerIsoImage(ctx, serverID, isoimageID)
 }
 
 //waitForServerISOImageRelCreation allows to wait until the relation between a server and an ISO-Image is created
-func (c *Client) waitForServerISOImageRelCreation(serverID, isoimageID string) error {
+func (c *Client) waitForServerISOImageRelCreation(ctx context.Context, serverID, isoimageID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(isoimageID) {
-		return errors.New("'serverID' and 'isoimageID' are required")
+		return newArgumentError("'serverID' and 'isoimageID' are required", "serverID", "isoimageID")
 	}
 	uri := path.Join(apiServerBase, serverID, "isoimages", isoimageID)
 	method := http.MethodGet
-	return c.waitFor200Status(uri, method)
+	return c.waitFor200Status(ctx, uri, method)
 }
 
 //waitForServerISOImageRelDeleted allows to wait until the relation between a server and an ISO-Image is deleted
-func (c *Client) waitForServerISOImageRelDeleted(serverID, isoimageID string) error {
+func (c *Client) waitForServerISOImageRelDeleted(ctx context.Context, serverID, isoimageID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(isoimageID) {
-		return errors.New("'serverID' and 'isoimageID' are required")
+		return newArgumentError("'serverID' and 'isoimageID' are required", "serverID", "isoimageID")
 	}
 	uri := path.Join(apiServerBase, serverID, "isoimages", isoimageID)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
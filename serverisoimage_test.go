@@ -18,7 +18,7 @@ func TestClient_GetServerIsoImageList(t *testing.T) {
 		fmt.Fprintf(writer, prepareServerIsoImageListHTTPGet())
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetServerIsoImageList(test.testUUID)
+		res, err := client.GetServerIsoImageList(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -39,7 +39,7 @@ func TestClient_GetServerIsoImage(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testISOImageID := range uuidCommonTestCases {
-			res, err := client.GetServerIsoImage(testServerID.testUUID, testISOImageID.testUUID)
+			res, err := client.GetServerIsoImage(emptyCtx, testServerID.testUUID, testISOImageID.testUUID)
 			if testServerID.isFailed || testISOImageID.isFailed {
 				assert.NotNil(t, err)
 			} else {
@@ -73,7 +73,7 @@ func TestClient_CreateServerIsoImage(t *testing.T) {
 			isFailed = test.isFailed
 			for _, testServerID := range uuidCommonTestCases {
 				for _, testISOImageID := range uuidCommonTestCases {
-					err := client.CreateServerIsoImage(testServerID.testUUID, ServerIsoImageRelationCreateRequest{
+					_, err := client.CreateServerIsoImage(emptyCtx, testServerID.testUUID, ServerIsoImageRelationCreateRequest{
 						ObjectUUID: testISOImageID.testUUID,
 					})
 					if testServerID.isFailed || testISOImageID.isFailed || isFailed {
@@ -98,9 +98,9 @@ func TestClient_UpdateServerIsoImage(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testISOImageID := range uuidCommonTestCases {
-			err := client.UpdateServerIsoImage(testServerID.testUUID, testISOImageID.testUUID, ServerIsoImageRelationUpdateRequest{
-				BootDevice: true,
-				Name:       "test",
+			_, err := client.UpdateServerIsoImage(emptyCtx, testServerID.testUUID, testISOImageID.testUUID, ServerIsoImageRelationUpdateRequest{
+				BootDevice: Bool(true),
+				Name:       String("test"),
 			})
 			if testServerID.isFailed || testISOImageID.isFailed {
 				assert.NotNil(t, err)
@@ -131,7 +131,7 @@ func TestClient_DeleteServerIsoImage(t *testing.T) {
 			isFailed = test.isFailed
 			for _, testServerID := range uuidCommonTestCases {
 				for _, testISOImageID := range uuidCommonTestCases {
-					err := client.DeleteServerIsoImage(testServerID.testUUID, testISOImageID.testUUID)
+					_, err := client.DeleteServerIsoImage(emptyCtx, testServerID.testUUID, testISOImageID.testUUID)
 					if testServerID.isFailed || testISOImageID.isFailed || isFailed {
 						assert.NotNil(t, err)
 					} else {
@@ -156,7 +156,7 @@ func TestClient_LinkIsoImage(t *testing.T) {
 		assert.Equal(t, http.MethodGet, request.Method)
 		fmt.Fprintf(writer, prepareServerIPHTTPGet())
 	})
-	err := client.LinkIsoImage(dummyUUID, dummyUUID)
+	_, err := client.LinkIsoImage(emptyCtx, dummyUUID, dummyUUID)
 	assert.Nil(t, err, "LinkIsoImage returned an error %v", err)
 
 }
@@ -172,7 +172,7 @@ func TestClient_UnlinkIsoImage(t *testing.T) {
 			writer.WriteHeader(404)
 		}
 	})
-	err := client.UnlinkIsoImage(dummyUUID, dummyUUID)
+	_, err := client.UnlinkIsoImage(emptyCtx, dummyUUID, dummyUUID)
 	assert.Nil(t, err, "UnlinkIsoImage returned an error %v", err)
 }
 
@@ -186,7 +186,7 @@ func TestClient_waitForServerISOImageRelCreation(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testIPID := range uuidCommonTestCases {
-			err := client.waitForServerISOImageRelCreation(testServerID.testUUID, testIPID.testUUID)
+			err := client.waitForServerISOImageRelCreation(emptyCtx, testServerID.testUUID, testIPID.testUUID)
 			if testServerID.isFailed || testIPID.isFailed {
 				assert.NotNil(t, err)
 			} else {
@@ -206,7 +206,7 @@ func TestClient_waitForServerISOImageRelDeleted(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testIPID := range uuidCommonTestCases {
-			err := client.waitForServerISOImageRelDeleted(testServerID.testUUID, testIPID.testUUID)
+			err := client.waitForServerISOImageRelDeleted(emptyCtx, testServerID.testUUID, testIPID.testUUID)
 			if testServerID.isFailed || testIPID.isFailed {
 				assert.NotNil(t, err)
 			} else {
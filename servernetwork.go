@@ -1,11 +1,23 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 )
 
+//ServerNetworkRelationOperator is an interface defining API of a server-network relation operator
+type ServerNetworkRelationOperator interface {
+	GetServerNetworkList(ctx context.Context, id string, opts ...ListOptions) ([]ServerNetworkRelationProperties, error)
+	GetServerNetwork(ctx context.Context, serverID, networkID string) (ServerNetworkRelationProperties, error)
+	UpdateServerNetwork(ctx context.Context, serverID, networkID string, body ServerNetworkRelationUpdateRequest) (*Operation, error)
+	CreateServerNetwork(ctx context.Context, id string, body ServerNetworkRelationCreateRequest) (*Operation, error)
+	DeleteServerNetwork(ctx context.Context, serverID, networkID string) (*Operation, error)
+	LinkNetwork(ctx context.Context, serverID, networkID, firewallTemplate string, bootdevice bool, order int,
+		l3security []string, firewall *FirewallRules) (*Operation, error)
+	UnlinkNetwork(ctx context.Context, serverID string, networkID string) (*Operation, error)
+}
+
 //ServerNetworkRelationList JSON struct of a list of relations between a server and networks
 type ServerNetworkRelationList struct {
 	//Array of relations between a server and networks
@@ -97,120 +109,153 @@ type ServerNetworkRelationCreateRequest struct {
 	FirewallTemplateUUID string `json:"firewall_template_uuid,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r ServerNetworkRelationCreateRequest) Validate() error {
+	var v validator
+	v.required("object_uuid", r.ObjectUUID)
+	v.atLeast("ordering", r.Ordering, 0)
+	if r.Firewall != nil {
+		v.firewallRules("firewall", *r.Firewall)
+	}
+	return v.err()
+}
+
 //ServerNetworkRelationUpdateRequest JSON struct of a request for updating a relation between a server and a network
 type ServerNetworkRelationUpdateRequest struct {
 	//The ordering of the network interfaces. Lower numbers have lower PCI-IDs. Optional.
-	Ordering int `json:"ordering,omitempty"`
+	Ordering *int `json:"ordering,omitempty"`
 
 	//The ordering of the network interfaces. Lower numbers have lower PCI-IDs. Optional.
-	BootDevice bool `json:"bootdevice,omitempty"`
+	BootDevice *bool `json:"bootdevice,omitempty"`
 
 	//Defines information about IP prefix spoof protection (it allows source traffic only from the IPv4/IPv4 network prefixes).
 	//If empty, it allow no IPv4/IPv6 source traffic. If set to null, l3security is disabled (default).
 	//Can be empty
-	L3security []string `json:"l3security,omitempty"`
+	L3security *[]string `json:"l3security,omitempty"`
 
 	//All rules of Firewall. Optional.
 	Firewall *FirewallRules `json:"firewall,omitempty"`
 
 	//Instead of setting firewall rules manually, you can use a firewall template by setting UUID of the firewall template.
 	//Optional.
-	FirewallTemplateUUID string `json:"firewall_template_uuid,omitempty"`
+	FirewallTemplateUUID *string `json:"firewall_template_uuid,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r ServerNetworkRelationUpdateRequest) Validate() error {
+	var v validator
+	if r.Ordering != nil {
+		v.atLeast("ordering", *r.Ordering, 0)
+	}
+	if r.Firewall != nil {
+		v.firewallRules("firewall", *r.Firewall)
+	}
+	return v.err()
 }
 
 //GetServerNetworkList gets a list of a specific server's networks
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedNetworks
-func (c *Client) GetServerNetworkList(id string) ([]ServerNetworkRelationProperties, error) {
+func (c *Client) GetServerNetworkList(ctx context.Context, id string, opts ...ListOptions) ([]ServerNetworkRelationProperties, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiServerBase, id, "networks"),
+		uri:    path.Join(apiServerBase, id, "networks") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ServerNetworkRelationList
-	err := r.execute(*c, &response)
-	return response.List, err
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	relations := response.List
+	return relations, options.apply(&relations)
 }
 
 //GetServerNetwork gets a network of a specific server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedNetwork
-func (c *Client) GetServerNetwork(serverID, networkID string) (ServerNetworkRelationProperties, error) {
+func (c *Client) GetServerNetwork(ctx context.Context, serverID, networkID string) (ServerNetworkRelationProperties, error) {
 	if !isValidUUID(serverID) || !isValidUUID(networkID) {
-		return ServerNetworkRelationProperties{}, errors.New("'serverID' or 'networksID' is invalid")
+		return ServerNetworkRelationProperties{}, newArgumentError("'serverID' or 'networksID' is invalid", "serverID", "networksID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "networks", networkID),
 		method: http.MethodGet,
 	}
 	var response ServerNetworkRelation
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response.Properties, err
 }
 
 //UpdateServerNetwork updates a link between a network and a server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateServerLinkedNetwork
-func (c *Client) UpdateServerNetwork(serverID, networkID string, body ServerNetworkRelationUpdateRequest) error {
+func (c *Client) UpdateServerNetwork(ctx context.Context, serverID, networkID string, body ServerNetworkRelationUpdateRequest) (*Operation, error) {
 	if !isValidUUID(serverID) || !isValidUUID(networkID) {
-		return errors.New("'serverID' or 'networksID' is invalid")
+		return nil, newArgumentError("'serverID' or 'networksID' is invalid", "serverID", "networksID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "networks", networkID),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	return r.execute(*c, nil)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newOperation(OperationUpdate, "server_network", serverID, r.requestUUID, nil), nil
 }
 
 //CreateServerNetwork creates a link between a network and a storage
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/linkNetworkToServer
-func (c *Client) CreateServerNetwork(id string, body ServerNetworkRelationCreateRequest) error {
+func (c *Client) CreateServerNetwork(ctx context.Context, id string, body ServerNetworkRelationCreateRequest) (*Operation, error) {
 	if !isValidUUID(id) || !isValidUUID(body.ObjectUUID) {
-		return errors.New("'serverID' or 'network_id' is invalid")
+		return nil, newArgumentError("'serverID' or 'network_id' is invalid", "serverID", "network_id")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id, "networks"),
 		method: http.MethodPost,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerNetworkRelCreation(id, body.ObjectUUID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationCreate, "server_network", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerNetworkRelCreation(ctx, id, body.ObjectUUID)
+	})
 }
 
 //DeleteServerNetwork deletes a link between a network and a server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/unlinkNetworkFromServer
-func (c *Client) DeleteServerNetwork(serverID, networkID string) error {
+func (c *Client) DeleteServerNetwork(ctx context.Context, serverID, networkID string) (*Operation, error) {
 	if !isValidUUID(serverID) || !isValidUUID(networkID) {
-		return errors.New("'serverID' or 'networkID' is invalid")
+		return nil, newArgumentError("'serverID' or 'networkID' is invalid", "serverID", "networkID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "networks", networkID),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerNetworkRelDeleted(serverID, networkID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "server_network", serverID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerNetworkRelDeleted(ctx, serverID, networkID)
+	})
 }
 
 //LinkNetwork attaches a network to a server
-func (c *Client) LinkNetwork(serverID, networkID, firewallTemplate string, bootdevice bool, order int,
-	l3security []string, firewall *FirewallRules) error {
+func (c *Client) LinkNetwork(ctx context.Context, serverID, networkID, firewallTemplate string, bootdevice bool, order int,
+	l3security []string, firewall *FirewallRules) (*Operation, error) {
 	body := ServerNetworkRelationCreateRequest{
 		ObjectUUID:           networkID,
 		Ordering:             order,
@@ -219,30 +264,30 @@ func (c *Client) LinkNetwork(serverID, networkID, firewallTemplate string, bootd
 		FirewallTemplateUUID: firewallTemplate,
 		Firewall:             firewall,
 	}
-	return c.CreateServerNetwork(serverID, body)
+	return c.CreateServerNetwork(ctx, serverID, body)
 }
 
 //UnlinkNetwork removes the link between a network and a server
-func (c *Client) UnlinkNetwork(serverID string, networkID string) error {
-	return c.DeleteServerNetwork(serverID, networkID)
+func (c *Client) UnlinkNetwork(ctx context.Context, serverID string, networkID string) (*Operation, error) {
+	return c.DeleteServerNetwork(ctx, serverID, networkID)
 }
 
 //waitForServerNetworkRelCreation allows to wait until the relation between a server and a network is created
-func (c *Client) waitForServerNetworkRelCreation(serverID, networkID string) error {
+func (c *Client) waitForServerNetworkRelCreation(ctx context.Context, serverID, networkID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(networkID) {
-		return errors.New("'serverID' and 'networkID' are required")
+		return newArgumentError("'serverID' and 'networkID' are required", "serverID", "networkID")
 	}
 	uri := path.Join(apiServerBase, serverID, "networks", networkID)
 	method := http.MethodGet
-	return c.waitFor200Status(uri, method)
+	return c.waitFor200Status(ctx, uri, method)
 }
 
 //waitForServerNetworkRelDeleted allows to wait until the relation between a server and a network is deleted
-func (c *Client) waitForServerNetworkRelDeleted(serverID, networkID string) error {
+func (c *Client) waitForServerNetworkRelDeleted(ctx context.Context, serverID, networkID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(networkID) {
-		return errors.New("'serverID' and 'networkID' are required")
+		return newArgumentError("'serverID' and 'networkID' are required", "serverID", "networkID")
 	}
 	uri := path.Join(apiServerBase, serverID, "networks", networkID)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -1,11 +1,22 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 )
 
+//ServerStorageRelationOperator is an interface defining API of a server-storage relation operator
+type ServerStorageRelationOperator interface {
+	GetServerStorageList(ctx context.Context, id string, opts ...ListOptions) ([]ServerStorageRelationProperties, error)
+	GetServerStorage(ctx context.Context, serverID, storageID string) (ServerStorageRelationProperties, error)
+	UpdateServerStorage(ctx context.Context, serverID, storageID string, body ServerStorageRelationUpdateRequest) (*Operation, error)
+	CreateServerStorage(ctx context.Context, id string, body ServerStorageRelationCreateRequest) (*Operation, error)
+	DeleteServerStorage(ctx context.Context, serverID, storageID string) (*Operation, error)
+	LinkStorage(ctx context.Context, serverID string, storageID string, bootdevice bool) (*Operation, error)
+	UnlinkStorage(ctx context.Context, serverID string, storageID string) (*Operation, error)
+}
+
 //ServerStorageRelationList JSON struct of a list of relations between a server and storages
 type ServerStorageRelationList struct {
 	//Array of relations between a server and storages
@@ -75,139 +86,165 @@ type ServerStorageRelationCreateRequest struct {
 	BootDevice bool `json:"bootdevice,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r ServerStorageRelationCreateRequest) Validate() error {
+	var v validator
+	v.required("object_uuid", r.ObjectUUID)
+	return v.err()
+}
+
 //ServerStorageRelationUpdateRequest JSON struct of a request for updating a relation between a server and a storage
 type ServerStorageRelationUpdateRequest struct {
 	//The ordering of the network interfaces. Lower numbers have lower PCI-IDs. Optional.
-	Ordering int `json:"ordering,omitempty"`
+	Ordering *int `json:"ordering,omitempty"`
 
 	//Whether the server boots from this network or not. Optional.
-	BootDevice bool `json:"bootdevice,omitempty"`
+	BootDevice *bool `json:"bootdevice,omitempty"`
 
 	//Defines information about IP prefix spoof protection (it allows source traffic only from the IPv4/IPv4 network prefixes).
 	//If empty, it allow no IPv4/IPv6 source traffic. If set to null, l3security is disabled (default). Optional.
-	L3security []string `json:"l3security,omitempty"`
+	L3security *[]string `json:"l3security,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r ServerStorageRelationUpdateRequest) Validate() error {
+	var v validator
+	if r.Ordering != nil {
+		v.atLeast("ordering", *r.Ordering, 0)
+	}
+	return v.err()
 }
 
 //GetServerStorageList gets a list of a specific server's storages
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedStorages
-func (c *Client) GetServerStorageList(id string) ([]ServerStorageRelationProperties, error) {
+func (c *Client) GetServerStorageList(ctx context.Context, id string, opts ...ListOptions) ([]ServerStorageRelationProperties, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiServerBase, id, "storages"),
+		uri:    path.Join(apiServerBase, id, "storages") + options.query(),
 		method: http.MethodGet,
 	}
 	var response ServerStorageRelationList
-	err := r.execute(*c, &response)
-	return response.List, err
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	relations := response.List
+	return relations, options.apply(&relations)
 }
 
 //GetServerStorage gets a storage of a specific server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getServerLinkedStorage
-func (c *Client) GetServerStorage(serverID, storageID string) (ServerStorageRelationProperties, error) {
+func (c *Client) GetServerStorage(ctx context.Context, serverID, storageID string) (ServerStorageRelationProperties, error) {
 	if !isValidUUID(serverID) || !isValidUUID(storageID) {
-		return ServerStorageRelationProperties{}, errors.New("'serverID' or 'storageID' is invalid")
+		return ServerStorageRelationProperties{}, newArgumentError("'serverID' or 'storageID' is invalid", "serverID", "storageID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "storages", storageID),
 		method: http.MethodGet,
 	}
 	var response ServerStorageRelationSingle
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response.Properties, err
 }
 
 //UpdateServerStorage updates a link between a storage and a server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateServerLinkedStorage
-func (c *Client) UpdateServerStorage(serverID, storageID string, body ServerStorageRelationUpdateRequest) error {
+func (c *Client) UpdateServerStorage(ctx context.Context, serverID, storageID string, body ServerStorageRelationUpdateRequest) (*Operation, error) {
 	if !isValidUUID(serverID) || !isValidUUID(storageID) {
-		return errors.New("'serverID' or 'storageID' is invalid")
+		return nil, newArgumentError("'serverID' or 'storageID' is invalid", "serverID", "storageID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "storages", storageID),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	return r.execute(*c, nil)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newOperation(OperationUpdate, "server_storage", serverID, r.requestUUID, nil), nil
 }
 
 //CreateServerStorage create a link between a server and a storage
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/linkStorageToServer
-func (c *Client) CreateServerStorage(id string, body ServerStorageRelationCreateRequest) error {
+func (c *Client) CreateServerStorage(ctx context.Context, id string, body ServerStorageRelationCreateRequest) (*Operation, error) {
 	if !isValidUUID(id) || !isValidUUID(body.ObjectUUID) {
-		return errors.New("'server_id' or 'storage_id' is invalid")
+		return nil, newArgumentError("'server_id' or 'storage_id' is invalid", "server_id", "storage_id")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id, "storages"),
 		method: http.MethodPost,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerStorageRelCreation(id, body.ObjectUUID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationCreate, "server_storage", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerStorageRelCreation(ctx, id, body.ObjectUUID)
+	})
 }
 
 //DeleteServerStorage delete a link between a storage and a server
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/unlinkStorageFromServer
-func (c *Client) DeleteServerStorage(serverID, storageID string) error {
+func (c *Client) DeleteServerStorage(ctx context.Context, serverID, storageID string) (*Operation, error) {
 	if !isValidUUID(serverID) || !isValidUUID(storageID) {
-		return errors.New("'serverID' or 'storageID' is invalid")
+		return nil, newArgumentError("'serverID' or 'storageID' is invalid", "serverID", "storageID")
 	}
 	r := Request{
 		uri:    path.Join(apiServerBase, serverID, "storages", storageID),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		return c.waitForServerStorageRelDeleted(serverID, storageID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "server_storage", serverID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForServerStorageRelDeleted(ctx, serverID, storageID)
+	})
 }
 
 //LinkStorage attaches a storage to a server
-func (c *Client) LinkStorage(serverID string, storageID string, bootdevice bool) error {
+func (c *Client) LinkStorage(ctx context.Context, serverID string, storageID string, bootdevice bool) (*Operation, error) {
 	body := ServerStorageRelationCreateRequest{
 		ObjectUUID: storageID,
 		BootDevice: bootdevice,
 	}
-	return c.CreateServerStorage(serverID, body)
+	return c.CreateServerStorage(ctx, serverID, body)
 }
 
 //UnlinkStorage remove a storage from a server
-func (c *Client) UnlinkStorage(serverID string, storageID string) error {
-	return c.DeleteServerStorage(serverID, storageID)
+func (c *Client) UnlinkStorage(ctx context.Context, serverID string, storageID string) (*Operation, error) {
+	return c.DeleteServerStorage(ctx, serverID, storageID)
 }
 
 //waitForServerStorageRelCreation allows to wait until the relation between a server and a storage is created
-func (c *Client) waitForServerStorageRelCreation(serverID, storageID string) error {
+func (c *Client) waitForServerStorageRelCreation(ctx context.Context, serverID, storageID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(storageID) {
-		return errors.New("'serverID' and 'storageID' are required")
+		return newArgumentError("'serverID' and 'storageID' are required", "serverID", "storageID")
 	}
 	uri := path.Join(apiServerBase, serverID, "storages", storageID)
 	method := http.MethodGet
-	return c.waitFor200Status(uri, method)
+	return c.waitFor200Status(ctx, uri, method)
 }
 
 //waitForServerStorageRelDeleted allows to wait until the relation between a server and a storage is deleted
-func (c *Client) waitForServerStorageRelDeleted(serverID, storageID string) error {
+func (c *Client) waitForServerStorageRelDeleted(ctx context.Context, serverID, storageID string) error {
 	if !isValidUUID(serverID) || !isValidUUID(storageID) {
-		return errors.New("'serverID' and 'storageID' are required")
+		return newArgumentError("'serverID' and 'storageID' are required", "serverID", "storageID")
 	}
 	uri := path.Join(apiServerBase, serverID, "storages", storageID)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
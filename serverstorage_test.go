@@ -18,7 +18,7 @@ func TestClient_GetServerStorageList(t *testing.T) {
 		fmt.Fprintf(writer, prepareServerStorageListHTTPGet())
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetServerStorageList(test.testUUID)
+		res, err := client.GetServerStorageList(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -39,7 +39,7 @@ func TestClient_GetServerStorage(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testStorageID := range uuidCommonTestCases {
-			res, err := client.GetServerStorage(testServerID.testUUID, testStorageID.testUUID)
+			res, err := client.GetServerStorage(emptyCtx, testServerID.testUUID, testStorageID.testUUID)
 			if testServerID.isFailed || testStorageID.isFailed {
 				assert.NotNil(t, err)
 			} else {
@@ -73,7 +73,7 @@ func TestClient_CreateServerStorage(t *testing.T) {
 			isFailed = test.isFailed
 			for _, testServerID := range uuidCommonTestCases {
 				for _, testStorageID := range uuidCommonTestCases {
-					err := client.CreateServerStorage(testServerID.testUUID, ServerStorageRelationCreateRequest{
+					_, err := client.CreateServerStorage(emptyCtx, testServerID.testUUID, ServerStorageRelationCreateRequest{
 						ObjectUUID: testStorageID.testUUID,
 						BootDevice: true,
 					})
@@ -99,9 +99,9 @@ func TestClient_UpdateServerStorage(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testStorageID := range uuidCommonTestCases {
-			err := client.UpdateServerStorage(testServerID.testUUID, testStorageID.testUUID, ServerStorageRelationUpdateRequest{
-				Ordering:   1,
-				BootDevice: true,
+			_, err := client.UpdateServerStorage(emptyCtx, testServerID.testUUID, testStorageID.testUUID, ServerStorageRelationUpdateRequest{
+				Ordering:   Int(1),
+				BootDevice: Bool(true),
 			})
 			if testServerID.isFailed || testStorageID.isFailed {
 				assert.NotNil(t, err)
@@ -132,7 +132,7 @@ func TestClient_DeleteServerStorage(t *testing.T) {
 			isFailed = test.isFailed
 			for _, testServerID := range uuidCommonTestCases {
 				for _, testStorageID := range uuidCommonTestCases {
-					err := client.DeleteServerStorage(testServerID.testUUID, testStorageID.testUUID)
+					_, err := client.DeleteServerStorage(emptyCtx, testServerID.testUUID, testStorageID.testUUID)
 					if testServerID.isFailed || testStorageID.isFailed || isFailed {
 						assert.NotNil(t, err)
 					} else {
@@ -157,7 +157,7 @@ func TestClient_LinkStorage(t *testing.T) {
 		assert.Equal(t, http.MethodGet, request.Method)
 		fmt.Fprintf(writer, prepareServerStorageHTTPGet())
 	})
-	err := client.LinkStorage(dummyUUID, dummyUUID, true)
+	_, err := client.LinkStorage(emptyCtx, dummyUUID, dummyUUID, true)
 	assert.Nil(t, err, "CreateServerStorage returned an error %v", err)
 
 }
@@ -174,7 +174,7 @@ func TestClient_UnlinkStorage(t *testing.T) {
 			writer.WriteHeader(404)
 		}
 	})
-	err := client.UnlinkStorage(dummyUUID, dummyUUID)
+	_, err := client.UnlinkStorage(emptyCtx, dummyUUID, dummyUUID)
 	assert.Nil(t, err, "UnlinkStorage returned an error %v", err)
 }
 
@@ -188,7 +188,7 @@ func TestClient_waitForServerStorageRelCreation(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testIPID := range uuidCommonTestCases {
-			err := client.waitForServerStorageRelCreation(testServerID.testUUID, testIPID.testUUID)
+			err := client.waitForServerStorageRelCreation(emptyCtx, testServerID.testUUID, testIPID.testUUID)
 			if testServerID.isFailed || testIPID.isFailed {
 				assert.NotNil(t, err)
 			} else {
@@ -208,7 +208,7 @@ func TestClient_waitForServerStorageRelDeleted(t *testing.T) {
 	})
 	for _, testServerID := range uuidCommonTestCases {
 		for _, testIPID := range uuidCommonTestCases {
-			err := client.waitForServerStorageRelDeleted(testServerID.testUUID, testIPID.testUUID)
+			err := client.waitForServerStorageRelDeleted(emptyCtx, testServerID.testUUID, testIPID.testUUID)
 			if testServerID.isFailed || testIPID.isFailed {
 				assert.NotNil(t, err)
 			} else {
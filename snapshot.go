@@ -1,11 +1,27 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//StorageSnapshotOperator is an interface defining API of a storage snapshot operator
+type StorageSnapshotOperator interface {
+	GetStorageSnapshotList(ctx context.Context, id string, opts ...ListOptions) ([]StorageSnapshot, error)
+	StorageSnapshotsIter(ctx context.Context, id string, yield func(StorageSnapshot) bool) error
+	GetStorageSnapshot(ctx context.Context, storageID, snapshotID string) (StorageSnapshot, error)
+	CreateStorageSnapshot(ctx context.Context, id string, body StorageSnapshotCreateRequest) (StorageSnapshotCreateResponse, *Operation, error)
+	UpdateStorageSnapshot(ctx context.Context, storageID, snapshotID string, body StorageSnapshotUpdateRequest) (*Operation, error)
+	UpdateStorageSnapshotFunc(ctx context.Context, storageID, snapshotID string, mutate func(*StorageSnapshotProperties) error) (*Operation, error)
+	DeleteStorageSnapshot(ctx context.Context, storageID, snapshotID string) (*Operation, error)
+	RollbackStorage(ctx context.Context, storageID, snapshotID string, body StorageRollbackRequest) (*Operation, error)
+	ExportStorageSnapshotToS3(ctx context.Context, storageID, snapshotID string, body StorageSnapshotExportToS3Request) (*Operation, error)
+	GetSnapshotsByLocation(ctx context.Context, id string, opts ...ListOptions) ([]StorageSnapshot, error)
+	GetDeletedSnapshots(ctx context.Context, opts ...ListOptions) ([]StorageSnapshot, error)
+}
+
 //StorageSnapshotList is JSON structure of a list of storage snapshots
 type StorageSnapshotList struct {
 	//Array of snapshots
@@ -83,6 +99,14 @@ type StorageSnapshotCreateRequest struct {
 	Labels []string `json:"labels,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r StorageSnapshotCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, true)
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //StorageSnapshotCreateResponse JSON struct of a response for creating a storage snapshot
 type StorageSnapshotCreateResponse struct {
 	//UUID of the request
@@ -96,10 +120,22 @@ type StorageSnapshotCreateResponse struct {
 type StorageSnapshotUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
 	//Optional
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//List of labels. Optional.
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r StorageSnapshotUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
 }
 
 //StorageRollbackRequest JSON struct of a request for rolling back
@@ -138,45 +174,74 @@ type StorageSnapshotExportToS3Request struct {
 //GetStorageSnapshotList gets a list of storage snapshots
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getSnapshots
-func (c *Client) GetStorageSnapshotList(id string) ([]StorageSnapshot, error) {
+func (c *Client) GetStorageSnapshotList(ctx context.Context, id string, opts ...ListOptions) ([]StorageSnapshot, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiStorageBase, id, "snapshots"),
+		uri:    path.Join(apiStorageBase, id, "snapshots") + options.query(),
 		method: http.MethodGet,
 	}
 	var response StorageSnapshotList
 	var snapshots []StorageSnapshot
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		snapshots = append(snapshots, StorageSnapshot{Properties: properties})
 	}
-	return snapshots, err
+	return snapshots, options.apply(&snapshots)
+}
+
+//StorageSnapshotsIter calls yield for all snapshots of a storage, in the order of the response. The response is
+//decoded one object at a time instead of being read into memory as a whole. Iterating stops early when yield returns
+//false or the context is done.
+func (c *Client) StorageSnapshotsIter(ctx context.Context, id string, yield func(StorageSnapshot) bool) error {
+	if !isValidUUID(id) {
+		return newArgumentError("'id' is invalid", "id")
+	}
+	return c.iterate(ctx, path.Join(apiStorageBase, id, "snapshots"), "snapshots", func(key string, dec *json.Decoder) (bool, error) {
+		var snapshot StorageSnapshot
+		if err := dec.Decode(&snapshot.Properties); err != nil {
+			return false, err
+		}
+		if snapshot.Properties.ObjectUUID == "" {
+			snapshot.Properties.ObjectUUID = key
+		}
+		return yield(snapshot), nil
+	})
 }
 
 //GetStorageSnapshot gets a specific storage's snapshot based on given storage id and snapshot id.
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getSnapshot
-func (c *Client) GetStorageSnapshot(storageID, snapshotID string) (StorageSnapshot, error) {
+func (c *Client) GetStorageSnapshot(ctx context.Context, storageID, snapshotID string) (StorageSnapshot, error) {
 	if !isValidUUID(storageID) || !isValidUUID(snapshotID) {
-		return StorageSnapshot{}, errors.New("'storageID' or 'snapshotID' is invalid")
+		return StorageSnapshot{}, newArgumentError("'storageID' or 'snapshotID' is invalid", "storageID", "snapshotID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshots", snapshotID),
 		method: http.MethodGet,
 	}
 	var response StorageSnapshot
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //CreateStorageSnapshot creates a new storage's snapshot
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createSnapshot
-func (c *Client) CreateStorageSnapshot(id string, body StorageSnapshotCreateRequest) (StorageSnapshotCreateResponse, error) {
+func (c *Client) CreateStorageSnapshot(ctx context.Context, id string, body StorageSnapshotCreateRequest) (StorageSnapshotCreateResponse, *Operation, error) {
 	if !isValidUUID(id) {
-		return StorageSnapshotCreateResponse{}, errors.New("'id' is invalid")
+		return StorageSnapshotCreateResponse{}, nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, id, "snapshots"),
@@ -184,158 +249,191 @@ func (c *Client) CreateStorageSnapshot(id string, body StorageSnapshotCreateRequ
 		body:   body,
 	}
 	var response StorageSnapshotCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return StorageSnapshotCreateResponse{}, err
+		return StorageSnapshotCreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "snapshot", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //UpdateStorageSnapshot updates a specific storage's snapshot
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateSnapshot
-func (c *Client) UpdateStorageSnapshot(storageID, snapshotID string, body StorageSnapshotUpdateRequest) error {
+func (c *Client) UpdateStorageSnapshot(ctx context.Context, storageID, snapshotID string, body StorageSnapshotUpdateRequest) (*Operation, error) {
 	if !isValidUUID(storageID) || !isValidUUID(snapshotID) {
-		return errors.New("'storageID' or 'snapshotID' is invalid")
+		return nil, newArgumentError("'storageID' or 'snapshotID' is invalid", "storageID", "snapshotID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshots", snapshotID),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSnapshotActive(storageID, snapshotID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "snapshot", snapshotID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSnapshotActive(ctx, storageID, snapshotID)
+	})
+}
+
+//UpdateStorageSnapshotFunc updates a storage's snapshot by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateStorageSnapshotFunc(ctx context.Context, storageID, snapshotID string, mutate func(*StorageSnapshotProperties) error) (*Operation, error) {
+	var body StorageSnapshotUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "snapshot",
+		objectUUID: snapshotID,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetStorageSnapshot(ctx, storageID, snapshotID)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*StorageSnapshotProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateStorageSnapshot(ctx, storageID, snapshotID, body)
+		},
+	})
 }
 
 //DeleteStorageSnapshot deletes a specific storage's snapshot
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteSnapshot
-func (c *Client) DeleteStorageSnapshot(storageID, snapshotID string) error {
+func (c *Client) DeleteStorageSnapshot(ctx context.Context, storageID, snapshotID string) (*Operation, error) {
 	if !isValidUUID(storageID) || !isValidUUID(snapshotID) {
-		return errors.New("'storageID' or 'snapshotID' is invalid")
+		return nil, newArgumentError("'storageID' or 'snapshotID' is invalid", "storageID", "snapshotID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshots", snapshotID),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSnapshotDeleted(storageID, snapshotID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "snapshot", snapshotID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSnapshotDeleted(ctx, storageID, snapshotID)
+	})
 }
 
 //RollbackStorage rollbacks a storage
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/StorageRollback
-func (c *Client) RollbackStorage(storageID, snapshotID string, body StorageRollbackRequest) error {
+func (c *Client) RollbackStorage(ctx context.Context, storageID, snapshotID string, body StorageRollbackRequest) (*Operation, error) {
 	if !isValidUUID(storageID) || !isValidUUID(snapshotID) {
-		return errors.New("'storageID' or 'snapshotID' is invalid")
+		return nil, newArgumentError("'storageID' or 'snapshotID' is invalid", "storageID", "snapshotID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshots", snapshotID, "rollback"),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSnapshotActive(storageID, snapshotID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationRollback, "storage", storageID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSnapshotActive(ctx, storageID, snapshotID)
+	})
 }
 
 //ExportStorageSnapshotToS3 export a storage's snapshot to S3
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/SnapshotExportToS3
-func (c *Client) ExportStorageSnapshotToS3(storageID, snapshotID string, body StorageSnapshotExportToS3Request) error {
+func (c *Client) ExportStorageSnapshotToS3(ctx context.Context, storageID, snapshotID string, body StorageSnapshotExportToS3Request) (*Operation, error) {
 	if !isValidUUID(storageID) || !isValidUUID(snapshotID) {
-		return errors.New("'storageID' and 'snapshotID' is invalid")
+		return nil, newArgumentError("'storageID' and 'snapshotID' is invalid", "storageID", "snapshotID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshots", snapshotID, "export_to_s3"),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSnapshotActive(storageID, snapshotID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationExport, "snapshot", snapshotID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSnapshotActive(ctx, storageID, snapshotID)
+	})
 }
 
 //GetSnapshotsByLocation gets a list of storage snapshots by location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocationSnapshots
-func (c *Client) GetSnapshotsByLocation(id string) ([]StorageSnapshot, error) {
+func (c *Client) GetSnapshotsByLocation(ctx context.Context, id string, opts ...ListOptions) ([]StorageSnapshot, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLocationBase, id, "snapshots"),
+		uri:    path.Join(apiLocationBase, id, "snapshots") + options.query(),
 		method: http.MethodGet,
 	}
 	var response StorageSnapshotList
 	var snapshots []StorageSnapshot
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		snapshots = append(snapshots, StorageSnapshot{Properties: properties})
 	}
-	return snapshots, err
+	return snapshots, options.apply(&snapshots)
 }
 
 //GetDeletedSnapshots gets a list of deleted storage snapshots
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedSnapshots
-func (c *Client) GetDeletedSnapshots() ([]StorageSnapshot, error) {
+func (c *Client) GetDeletedSnapshots(ctx context.Context, opts ...ListOptions) ([]StorageSnapshot, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "snapshots"),
+		uri:    path.Join(apiDeletedBase, "snapshots") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedStorageSnapshotList
 	var snapshots []StorageSnapshot
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		snapshots = append(snapshots, StorageSnapshot{Properties: properties})
 	}
-	return snapshots, err
+	return snapshots, options.apply(&snapshots)
 }
 
 //waitForSnapshotActive allows to wait until the snapshot's status is active
-func (c *Client) waitForSnapshotActive(storageID, snapshotID string) error {
-	return retryWithTimeout(func() (bool, error) {
-		snapshot, err := c.GetStorageSnapshot(storageID, snapshotID)
-		return snapshot.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForSnapshotActive(ctx context.Context, storageID, snapshotID string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetStorageSnapshot(ctx, storageID, snapshotID)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForSnapshotDeleted allows to wait until the snapshot is deleted
-func (c *Client) waitForSnapshotDeleted(storageID, snapshotID string) error {
+func (c *Client) waitForSnapshotDeleted(ctx context.Context, storageID, snapshotID string) error {
 	if !isValidUUID(storageID) || !isValidUUID(snapshotID) {
-		return errors.New("'storageID' or 'snapshotID' is invalid")
+		return newArgumentError("'storageID' or 'snapshotID' is invalid", "storageID", "snapshotID")
 	}
 	uri := path.Join(apiStorageBase, storageID, "snapshots", snapshotID)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -18,7 +18,7 @@ func TestClient_GetStorageSnapshotList(t *testing.T) {
 		fmt.Fprintf(writer, prepareStorageSnapshotListHTTPGet())
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetStorageSnapshotList(test.testUUID)
+		res, err := client.GetStorageSnapshotList(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -39,7 +39,7 @@ func TestClient_GetStorageSnapshot(t *testing.T) {
 	})
 	for _, testStorageID := range uuidCommonTestCases {
 		for _, testSnapshotID := range uuidCommonTestCases {
-			res, err := client.GetStorageSnapshot(testStorageID.testUUID, testSnapshotID.testUUID)
+			res, err := client.GetStorageSnapshot(emptyCtx, testStorageID.testUUID, testSnapshotID.testUUID)
 			if testStorageID.isFailed || testSnapshotID.isFailed {
 				assert.NotNil(t, err)
 			} else {
@@ -72,7 +72,7 @@ func TestClient_CreateStorageSnapshot(t *testing.T) {
 		for _, test := range commonSuccessFailTestCases {
 			isFailed = test.isFailed
 			for _, test := range uuidCommonTestCases {
-				response, err := client.CreateStorageSnapshot(test.testUUID, StorageSnapshotCreateRequest{
+				response, _, err := client.CreateStorageSnapshot(emptyCtx, test.testUUID, StorageSnapshotCreateRequest{
 					Name:   "test",
 					Labels: []string{"label"},
 				})
@@ -108,9 +108,9 @@ func TestClient_UpdateStorageSnapshot(t *testing.T) {
 			isFailed = serverTest.isFailed
 			for _, testStorageID := range uuidCommonTestCases {
 				for _, testSnapshotID := range uuidCommonTestCases {
-					err := client.UpdateStorageSnapshot(testStorageID.testUUID, testSnapshotID.testUUID, StorageSnapshotUpdateRequest{
-						Name:   "test",
-						Labels: []string{"label"},
+					_, err := client.UpdateStorageSnapshot(emptyCtx, testStorageID.testUUID, testSnapshotID.testUUID, StorageSnapshotUpdateRequest{
+						Name:   String("test"),
+						Labels: Strings("label"),
 					})
 					if testStorageID.isFailed || testSnapshotID.isFailed || isFailed {
 						assert.NotNil(t, err)
@@ -144,7 +144,7 @@ func TestClient_DeleteStorageSnapshot(t *testing.T) {
 			isFailed = serverTest.isFailed
 			for _, testStorageID := range uuidCommonTestCases {
 				for _, testSnapshotID := range uuidCommonTestCases {
-					err := client.DeleteStorageSnapshot(testStorageID.testUUID, testSnapshotID.testUUID)
+					_, err := client.DeleteStorageSnapshot(emptyCtx, testStorageID.testUUID, testSnapshotID.testUUID)
 					if testStorageID.isFailed || testSnapshotID.isFailed || isFailed {
 						assert.NotNil(t, err)
 					} else {
@@ -182,7 +182,7 @@ func TestClient_RollbackStorage(t *testing.T) {
 			isFailed = serverTest.isFailed
 			for _, testStorageID := range uuidCommonTestCases {
 				for _, testSnapshotID := range uuidCommonTestCases {
-					err := client.RollbackStorage(testStorageID.testUUID, testSnapshotID.testUUID, StorageRollbackRequest{Rollback: true})
+					_, err := client.RollbackStorage(emptyCtx, testStorageID.testUUID, testSnapshotID.testUUID, StorageRollbackRequest{Rollback: true})
 					if testStorageID.isFailed || testSnapshotID.isFailed || isFailed {
 						assert.NotNil(t, err)
 					} else {
@@ -220,7 +220,7 @@ func TestClient_ExportStorageSnapshotToS3(t *testing.T) {
 			isFailed = serverTest.isFailed
 			for _, testStorageID := range uuidCommonTestCases {
 				for _, testSnapshotID := range uuidCommonTestCases {
-					err := client.ExportStorageSnapshotToS3(testStorageID.testUUID, testSnapshotID.testUUID, StorageSnapshotExportToS3Request{
+					_, err := client.ExportStorageSnapshotToS3(emptyCtx, testStorageID.testUUID, testSnapshotID.testUUID, StorageSnapshotExportToS3Request{
 						S3auth: struct {
 							Host      string `json:"host"`
 							AccessKey string `json:"access_key"`
@@ -263,7 +263,7 @@ func TestClient_GetSnapshotsByLocation(t *testing.T) {
 		fmt.Fprintf(writer, prepareStorageSnapshotListHTTPGet())
 	})
 	for _, test := range uuidCommonTestCases {
-		res, err := client.GetSnapshotsByLocation(test.testUUID)
+		res, err := client.GetSnapshotsByLocation(emptyCtx, test.testUUID)
 		if test.isFailed {
 			assert.NotNil(t, err)
 		} else {
@@ -283,7 +283,7 @@ func TestClient_GetDeletedSnapshots(t *testing.T) {
 		fmt.Fprintf(writer, prepareDeletedStorageSnapshotListHTTPGet())
 	})
 
-	res, err := client.GetDeletedSnapshots()
+	res, err := client.GetDeletedSnapshots(emptyCtx)
 	assert.Nil(t, err, "GetSnapshotsByLocation returned an error %v", err)
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockStorageSnapshot("deleted")), fmt.Sprintf("%v", res))
@@ -297,7 +297,7 @@ func TestClient_waitForSnapshotActive(t *testing.T) {
 		assert.Equal(t, http.MethodGet, request.Method)
 		fmt.Fprint(writer, prepareStorageSnapshotHTTPGet("active"))
 	})
-	err := client.waitForSnapshotActive(dummyUUID, dummyUUID)
+	err := client.waitForSnapshotActive(emptyCtx, dummyUUID, dummyUUID)
 	assert.Nil(t, err, "waitForSnapshotActive returned an error %v", err)
 }
 
@@ -311,7 +311,7 @@ func TestClient_waitForSnapshotDeleted(t *testing.T) {
 	})
 	for _, testStorageID := range uuidCommonTestCases {
 		for _, testSnapshotID := range uuidCommonTestCases {
-			err := client.waitForSnapshotDeleted(testStorageID.testUUID, testSnapshotID.testUUID)
+			err := client.waitForSnapshotDeleted(emptyCtx, testStorageID.testUUID, testSnapshotID.testUUID)
 			if testStorageID.isFailed || testSnapshotID.isFailed {
 				assert.NotNil(t, err)
 			} else {
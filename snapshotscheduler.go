@@ -1,11 +1,21 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
 	"net/http"
 	"path"
 )
 
+//StorageSnapshotScheduleOperator is an interface defining API of a storage snapshot schedule operator
+type StorageSnapshotScheduleOperator interface {
+	GetStorageSnapshotScheduleList(ctx context.Context, id string, opts ...ListOptions) ([]StorageSnapshotSchedule, error)
+	GetStorageSnapshotSchedule(ctx context.Context, storageID, scheduleID string) (StorageSnapshotSchedule, error)
+	CreateStorageSnapshotSchedule(ctx context.Context, id string, body StorageSnapshotScheduleCreateRequest) (StorageSnapshotScheduleCreateResponse, *Operation, error)
+	UpdateStorageSnapshotSchedule(ctx context.Context, storageID, scheduleID string, body StorageSnapshotScheduleUpdateRequest) (*Operation, error)
+	UpdateStorageSnapshotScheduleFunc(ctx context.Context, storageID, scheduleID string, mutate func(*StorageSnapshotScheduleProperties) error) (*Operation, error)
+	DeleteStorageSnapshotSchedule(ctx context.Context, storageID, scheduleID string) (*Operation, error)
+}
+
 //StorageSnapshotScheduleList JSON of a list of storage snapshot schedules
 type StorageSnapshotScheduleList struct {
 	//Array of storage snapshot schedules
@@ -94,6 +104,16 @@ type StorageSnapshotScheduleCreateRequest struct {
 	NextRuntime *GSTime `json:"next_runtime,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r StorageSnapshotScheduleCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.labels("labels", r.Labels)
+	v.atLeast("run_interval", r.RunInterval, minRunInterval)
+	v.atLeast("keep_snapshots", r.KeepSnapshots, minKeepSnapshots)
+	return v.err()
+}
+
 //StorageSnapshotScheduleCreateResponse JSON struct of a response for creating a storage snapshot schedule
 type StorageSnapshotScheduleCreateResponse struct {
 	//UUID of the request
@@ -107,66 +127,94 @@ type StorageSnapshotScheduleCreateResponse struct {
 type StorageSnapshotScheduleUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
 	//Optional.
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//List of labels. Optional.
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
 
 	//The interval at which the schedule will run (in minutes). Optional.
 	//Allowed value >= 60
-	RunInterval int `json:"run_interval,omitempty"`
+	RunInterval *int `json:"run_interval,omitempty"`
 
 	//The amount of Snapshots to keep before overwriting the last created Snapshot. Optional.
 	//Allowed value >= 1
-	KeepSnapshots int `json:"keep_snapshots,omitempty"`
+	KeepSnapshots *int `json:"keep_snapshots,omitempty"`
 
 	//The date and time that the snapshot schedule will be run. Optional.
 	NextRuntime *GSTime `json:"next_runtime,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r StorageSnapshotScheduleUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	if r.RunInterval != nil {
+		v.atLeast("run_interval", *r.RunInterval, minRunInterval)
+	}
+	if r.KeepSnapshots != nil {
+		v.atLeast("keep_snapshots", *r.KeepSnapshots, minKeepSnapshots)
+	}
+	return v.err()
+}
+
 //GetStorageSnapshotScheduleList gets a list of available storage snapshot schedules based on a given storage's id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getSnapshotSchedules
-func (c *Client) GetStorageSnapshotScheduleList(id string) ([]StorageSnapshotSchedule, error) {
+func (c *Client) GetStorageSnapshotScheduleList(ctx context.Context, id string, opts ...ListOptions) ([]StorageSnapshotSchedule, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiStorageBase, id, "snapshot_schedules"),
+		uri:    path.Join(apiStorageBase, id, "snapshot_schedules") + options.query(),
 		method: http.MethodGet,
 	}
 	var response StorageSnapshotScheduleList
 	var schedules []StorageSnapshotSchedule
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		schedules = append(schedules, StorageSnapshotSchedule{Properties: properties})
 	}
-	return schedules, err
+	return schedules, options.apply(&schedules)
 }
 
 //GetStorageSnapshotSchedule gets a specific storage snapshot scheduler based on a given storage's id and scheduler's id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getSnapshotSchedule
-func (c *Client) GetStorageSnapshotSchedule(storageID, scheduleID string) (StorageSnapshotSchedule, error) {
+func (c *Client) GetStorageSnapshotSchedule(ctx context.Context, storageID, scheduleID string) (StorageSnapshotSchedule, error) {
 	if !isValidUUID(storageID) || !isValidUUID(scheduleID) {
-		return StorageSnapshotSchedule{}, errors.New("'storageID' or 'scheduleID' is invalid")
+		return StorageSnapshotSchedule{}, newArgumentError("'storageID' or 'scheduleID' is invalid", "storageID", "scheduleID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshot_schedules", scheduleID),
 		method: http.MethodGet,
 	}
 	var response StorageSnapshotSchedule
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //CreateStorageSnapshotSchedule create a storage's snapshot scheduler
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createSnapshotSchedule
-func (c *Client) CreateStorageSnapshotSchedule(id string, body StorageSnapshotScheduleCreateRequest) (
-	StorageSnapshotScheduleCreateResponse, error) {
+func (c *Client) CreateStorageSnapshotSchedule(ctx context.Context, id string, body StorageSnapshotScheduleCreateRequest) (
+	StorageSnapshotScheduleCreateResponse, *Operation, error) {
 	if !isValidUUID(id) {
-		return StorageSnapshotScheduleCreateResponse{}, errors.New("'id' is invalid")
+		return StorageSnapshotScheduleCreateResponse{}, nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, id, "snapshot_schedules"),
@@ -174,76 +222,93 @@ func (c *Client) CreateStorageSnapshotSchedule(id string, body StorageSnapshotSc
 		body:   body,
 	}
 	var response StorageSnapshotScheduleCreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return StorageSnapshotScheduleCreateResponse{}, err
-	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
+		return StorageSnapshotScheduleCreateResponse{}, nil, err
 	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "snapshot_schedule", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //UpdateStorageSnapshotSchedule updates specific Storage's snapshot scheduler based on a given storage's id and scheduler's id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateSnapshotSchedule
-func (c *Client) UpdateStorageSnapshotSchedule(storageID, scheduleID string,
-	body StorageSnapshotScheduleUpdateRequest) error {
+func (c *Client) UpdateStorageSnapshotSchedule(ctx context.Context, storageID, scheduleID string,
+	body StorageSnapshotScheduleUpdateRequest) (*Operation, error) {
 	if !isValidUUID(storageID) || !isValidUUID(scheduleID) {
-		return errors.New("'storageID' or 'scheduleID' is invalid")
+		return nil, newArgumentError("'storageID' or 'scheduleID' is invalid", "storageID", "scheduleID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshot_schedules", scheduleID),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSnapshotScheduleActive(storageID, scheduleID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "snapshot_schedule", scheduleID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSnapshotScheduleActive(ctx, storageID, scheduleID)
+	})
+}
+
+//UpdateStorageSnapshotScheduleFunc updates a storage's snapshot schedule by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateStorageSnapshotScheduleFunc(ctx context.Context, storageID, scheduleID string, mutate func(*StorageSnapshotScheduleProperties) error) (*Operation, error) {
+	var body StorageSnapshotScheduleUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "snapshot_schedule",
+		objectUUID: scheduleID,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetStorageSnapshotSchedule(ctx, storageID, scheduleID)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*StorageSnapshotScheduleProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateStorageSnapshotSchedule(ctx, storageID, scheduleID, body)
+		},
+	})
 }
 
 //DeleteStorageSnapshotSchedule deletes specific Storage's snapshot scheduler based on a given storage's id and scheduler's id
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteSnapshotSchedule
-func (c *Client) DeleteStorageSnapshotSchedule(storageID, scheduleID string) error {
+func (c *Client) DeleteStorageSnapshotSchedule(ctx context.Context, storageID, scheduleID string) (*Operation, error) {
 	if !isValidUUID(storageID) || !isValidUUID(scheduleID) {
-		return errors.New("'storageID' or 'scheduleID' is invalid")
+		return nil, newArgumentError("'storageID' or 'scheduleID' is invalid", "storageID", "scheduleID")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, storageID, "snapshot_schedules", scheduleID),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSnapshotScheduleDeleted(storageID, scheduleID)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "snapshot_schedule", scheduleID, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSnapshotScheduleDeleted(ctx, storageID, scheduleID)
+	})
 }
 
 //waitForSnapshotScheduleActive allows to wait until the snapshot schedule's status is active
-func (c *Client) waitForSnapshotScheduleActive(storageID, scheduleID string) error {
-	return retryWithTimeout(func() (bool, error) {
-		schedule, err := c.GetStorageSnapshotSchedule(storageID, scheduleID)
-		return schedule.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForSnapshotScheduleActive(ctx context.Context, storageID, scheduleID string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetStorageSnapshotSchedule(ctx, storageID, scheduleID)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForSnapshotScheduleDeleted allows to wait until the snapshot schedule deleted
-func (c *Client) waitForSnapshotScheduleDeleted(storageID, scheduleID string) error {
+func (c *Client) waitForSnapshotScheduleDeleted(ctx context.Context, storageID, scheduleID string) error {
 	if !isValidUUID(storageID) || !isValidUUID(scheduleID) {
-		return errors.New("'storageID' or 'scheduleID' is invalid")
+		return newArgumentError("'storageID' or 'scheduleID' is invalid", "storageID", "scheduleID")
 	}
 	uri := path.Join(apiStorageBase, storageID, "snapshot_schedules", scheduleID)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -1,11 +1,24 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//SshKeyOperator is an interface defining API of an SSH-key operator
+type SshKeyOperator interface {
+	GetSshkey(ctx context.Context, id string) (Sshkey, error)
+	GetSshkeyList(ctx context.Context, opts ...ListOptions) ([]Sshkey, error)
+	SshkeysIter(ctx context.Context, yield func(Sshkey) bool) error
+	CreateSshkey(ctx context.Context, body SshkeyCreateRequest) (CreateResponse, *Operation, error)
+	DeleteSshkey(ctx context.Context, id string) (*Operation, error)
+	UpdateSshkey(ctx context.Context, id string, body SshkeyUpdateRequest) (*Operation, error)
+	UpdateSshkeyFunc(ctx context.Context, id string, mutate func(*SshkeyProperties) error) (*Operation, error)
+	GetSshkeyEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+}
+
 //SshkeyList JSON struct of a list of SSH-keys
 type SshkeyList struct {
 	//Array of SSH-keys
@@ -57,152 +70,226 @@ type SshkeyCreateRequest struct {
 	Labels []string `json:"labels,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r SshkeyCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.required("sshkey", r.Sshkey)
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //SshkeyUpdateRequest JSON struct of a request for updating a SSH-key
 type SshkeyUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
 	//Optional.
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//The OpenSSH public key string (all key types are supported => ed25519, ecdsa, dsa, rsa, rsa1). Optional.
-	Sshkey string `json:"sshkey,omitempty"`
+	Sshkey *string `json:"sshkey,omitempty"`
 
 	//List of labels. Optional.
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r SshkeyUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Sshkey != nil {
+		v.required("sshkey", *r.Sshkey)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
 }
 
 //GetSshkey gets a ssh key
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getSshKey
-func (c *Client) GetSshkey(id string) (Sshkey, error) {
+func (c *Client) GetSshkey(ctx context.Context, id string) (Sshkey, error) {
 	if !isValidUUID(id) {
-		return Sshkey{}, errors.New("'id' is invalid")
+		return Sshkey{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiSshkeyBase, id),
 		method: http.MethodGet,
 	}
 	var response Sshkey
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //GetSshkeyList gets a list of ssh keys
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getSshKeys
-func (c *Client) GetSshkeyList() ([]Sshkey, error) {
+func (c *Client) GetSshkeyList(ctx context.Context, opts ...ListOptions) ([]Sshkey, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiSshkeyBase,
+		uri:    apiSshkeyBase + options.query(),
 		method: http.MethodGet,
 	}
 
 	var response SshkeyList
 	var sshKeys []Sshkey
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		sshKeys = append(sshKeys, Sshkey{Properties: properties})
 	}
-	return sshKeys, err
+	return sshKeys, options.apply(&sshKeys)
+}
+
+//SshkeysIter calls yield for all SSH keys, in the order of the response. The response is decoded one object at a
+//time instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) SshkeysIter(ctx context.Context, yield func(Sshkey) bool) error {
+	return c.iterate(ctx, apiSshkeyBase, "sshkeys", func(key string, dec *json.Decoder) (bool, error) {
+		var sshkey Sshkey
+		if err := dec.Decode(&sshkey.Properties); err != nil {
+			return false, err
+		}
+		if sshkey.Properties.ObjectUUID == "" {
+			sshkey.Properties.ObjectUUID = key
+		}
+		return yield(sshkey), nil
+	})
 }
 
 //CreateSshkey creates a ssh key
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createSshKey
-func (c *Client) CreateSshkey(body SshkeyCreateRequest) (CreateResponse, error) {
+func (c *Client) CreateSshkey(ctx context.Context, body SshkeyCreateRequest) (CreateResponse, *Operation, error) {
 	r := Request{
 		uri:    apiSshkeyBase,
 		method: "POST",
 		body:   body,
 	}
 	var response CreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return CreateResponse{}, err
+		return CreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "sshkey", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //DeleteSshkey deletes a ssh key
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteSshKey
-func (c *Client) DeleteSshkey(id string) error {
+func (c *Client) DeleteSshkey(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiSshkeyBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSSHKeyDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "sshkey", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSSHKeyDeleted(ctx, id)
+	})
 }
 
 //UpdateSshkey updates a ssh key
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateSshKey
-func (c *Client) UpdateSshkey(id string, body SshkeyUpdateRequest) error {
+func (c *Client) UpdateSshkey(ctx context.Context, id string, body SshkeyUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiSshkeyBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForSSHKeyActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "sshkey", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForSSHKeyActive(ctx, id)
+	})
+}
+
+//UpdateSshkeyFunc updates an SSH key by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateSshkeyFunc(ctx context.Context, id string, mutate func(*SshkeyProperties) error) (*Operation, error) {
+	var body SshkeyUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "sshkey",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetSshkey(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*SshkeyProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateSshkey(ctx, id, body)
+		},
+	})
 }
 
 //GetSshkeyEventList gets a ssh key's events
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getSshKeyEvents
-func (c *Client) GetSshkeyEventList(id string) ([]Event, error) {
+func (c *Client) GetSshkeyEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiSshkeyBase, id, "events"),
+		uri:    path.Join(apiSshkeyBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var sshEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		sshEvents = append(sshEvents, Event{Properties: properties})
 	}
-	return sshEvents, err
+	return sshEvents, options.apply(&sshEvents)
 }
 
 //waitForSSHKeyActive allows to wait until the SSH-Key's status is active
-func (c *Client) waitForSSHKeyActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		key, err := c.GetSshkey(id)
-		return key.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForSSHKeyActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetSshkey(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForSSHKeyDeleted allows to wait until the SSH-Key is deleted
-func (c *Client) waitForSSHKeyDeleted(id string) error {
+func (c *Client) waitForSSHKeyDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiSshkeyBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -1,11 +1,26 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
 
+//StorageOperator is an interface defining API of a storage operator
+type StorageOperator interface {
+	GetStorage(ctx context.Context, id string) (Storage, error)
+	GetStorageList(ctx context.Context, opts ...ListOptions) ([]Storage, error)
+	StoragesIter(ctx context.Context, yield func(Storage) bool) error
+	CreateStorage(ctx context.Context, body StorageCreateRequest) (CreateResponse, *Operation, error)
+	DeleteStorage(ctx context.Context, id string) (*Operation, error)
+	UpdateStorage(ctx context.Context, id string, body StorageUpdateRequest) (*Operation, error)
+	UpdateStorageFunc(ctx context.Context, id string, mutate func(*StorageProperties) error) (*Operation, error)
+	GetStorageEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+	GetStoragesByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Storage, error)
+	GetDeletedStorages(ctx context.Context, opts ...ListOptions) ([]Storage, error)
+}
+
 //StorageList JSON struct of a list of storages
 type StorageList struct {
 	//Array of storages
@@ -218,16 +233,43 @@ type StorageCreateRequest struct {
 	Labels []string `json:"labels,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r StorageCreateRequest) Validate() error {
+	var v validator
+	v.between("capacity", r.Capacity, minStorageCapacity, maxStorageCapacity)
+	v.name("name", r.Name, false)
+	if r.Template != nil {
+		v.required("template.template_uuid", r.Template.TemplateUUID)
+	}
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //StorageUpdateRequest JSON struct of a request for updating a storage
 type StorageUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters. Optional.
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//List of labels. Optional.
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
 
 	//The Capacity of the Storage in GB. Optional.
-	Capacity int `json:"capacity,omitempty"`
+	Capacity *int `json:"capacity,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r StorageUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	if r.Capacity != nil {
+		v.between("capacity", *r.Capacity, minStorageCapacity, maxStorageCapacity)
+	}
+	return v.err()
 }
 
 //All allowed storage type's values
@@ -246,36 +288,62 @@ var (
 //GetStorage get a storage
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getStorage
-func (c *Client) GetStorage(id string) (Storage, error) {
+func (c *Client) GetStorage(ctx context.Context, id string) (Storage, error) {
 	if !isValidUUID(id) {
-		return Storage{}, errors.New("'id' is invalid")
+		return Storage{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, id),
 		method: http.MethodGet,
 	}
 	var response Storage
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //GetStorageList gets a list of available storages
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getStorages
-func (c *Client) GetStorageList() ([]Storage, error) {
+func (c *Client) GetStorageList(ctx context.Context, opts ...ListOptions) ([]Storage, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiStorageBase,
+		uri:    apiStorageBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response StorageList
 	var storages []Storage
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		storages = append(storages, Storage{
 			Properties: properties,
 		})
 	}
-	return storages, err
+	return storages, options.apply(&storages)
+}
+
+//StoragesIter calls yield for all storages, in the order of the response. The response is decoded one object at a
+//time instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) StoragesIter(ctx context.Context, yield func(Storage) bool) error {
+	return c.iterate(ctx, apiStorageBase, "storages", func(key string, dec *json.Decoder) (bool, error) {
+		var storage Storage
+		if err := dec.Decode(&storage.Properties); err != nil {
+			return false, err
+		}
+		if storage.Properties.ObjectUUID == "" {
+			storage.Properties.ObjectUUID = key
+		}
+		return yield(storage), nil
+	})
 }
 
 //CreateStorage create a storage
@@ -287,139 +355,186 @@ func (c *Client) GetStorageList() ([]Storage, error) {
 // - Allowed value for `PasswordType`: nil, PlainPasswordType, CryptPasswordType.
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createStorage
-func (c *Client) CreateStorage(body StorageCreateRequest) (CreateResponse, error) {
+func (c *Client) CreateStorage(ctx context.Context, body StorageCreateRequest) (CreateResponse, *Operation, error) {
+	if body.LocationUUID == "" {
+		body.LocationUUID = c.cfg.locationUUID
+	}
 	r := Request{
 		uri:    apiStorageBase,
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response CreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return CreateResponse{}, err
-	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
+		return CreateResponse{}, nil, err
 	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "storage", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //DeleteStorage delete a storage
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteStorage
-func (c *Client) DeleteStorage(id string) error {
+func (c *Client) DeleteStorage(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForStorageDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "storage", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForStorageDeleted(ctx, id)
+	})
 }
 
 //UpdateStorage update a storage
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateStorage
-func (c *Client) UpdateStorage(id string, body StorageUpdateRequest) error {
+func (c *Client) UpdateStorage(ctx context.Context, id string, body StorageUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiStorageBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForStorageActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "storage", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForStorageActive(ctx, id)
+	})
+}
+
+//UpdateStorageFunc updates a storage by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateStorageFunc(ctx context.Context, id string, mutate func(*StorageProperties) error) (*Operation, error) {
+	var body StorageUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "storage",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetStorage(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*StorageProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateStorage(ctx, id, body)
+		},
+	})
 }
 
 //GetStorageEventList get list of a storage's event
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getStorageEvents
-func (c *Client) GetStorageEventList(id string) ([]Event, error) {
+func (c *Client) GetStorageEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiStorageBase, id, "events"),
+		uri:    path.Join(apiStorageBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var storageEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		storageEvents = append(storageEvents, Event{Properties: properties})
 	}
-	return storageEvents, err
+	return storageEvents, options.apply(&storageEvents)
 }
 
 //GetStoragesByLocation gets a list of storages by location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocationStorages
-func (c *Client) GetStoragesByLocation(id string) ([]Storage, error) {
+func (c *Client) GetStoragesByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Storage, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLocationBase, id, "storages"),
+		uri:    path.Join(apiLocationBase, id, "storages") + options.query(),
 		method: http.MethodGet,
 	}
 	var response StorageList
 	var storages []Storage
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		storages = append(storages, Storage{Properties: properties})
 	}
-	return storages, err
+	return storages, options.apply(&storages)
 }
 
 //GetDeletedStorages gets a list of deleted storages
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedStorages
-func (c *Client) GetDeletedStorages() ([]Storage, error) {
+func (c *Client) GetDeletedStorages(ctx context.Context, opts ...ListOptions) ([]Storage, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "storages"),
+		uri:    path.Join(apiDeletedBase, "storages") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedStorageList
 	var storages []Storage
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		storages = append(storages, Storage{Properties: properties})
 	}
-	return storages, err
+	return storages, options.apply(&storages)
 }
 
 //waitForStorageActive allows to wait until the storage's status is active
-func (c *Client) waitForStorageActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		storage, err := c.GetStorage(id)
-		return storage.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForStorageActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetStorage(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForStorageDeleted allows to wait until the storage is deleted
-func (c *Client) waitForStorageDeleted(id string) error {
+func (c *Client) waitForStorageDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiStorageBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
@@ -1,12 +1,28 @@
 package gsclient
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"path"
 )
 
+//TemplateOperator is an interface defining API of a template operator
+type TemplateOperator interface {
+	GetTemplate(ctx context.Context, id string) (Template, error)
+	GetTemplateList(ctx context.Context, opts ...ListOptions) ([]Template, error)
+	TemplatesIter(ctx context.Context, yield func(Template) bool) error
+	GetTemplateByName(ctx context.Context, name string) (Template, error)
+	CreateTemplate(ctx context.Context, body TemplateCreateRequest) (CreateResponse, *Operation, error)
+	UpdateTemplate(ctx context.Context, id string, body TemplateUpdateRequest) (*Operation, error)
+	UpdateTemplateFunc(ctx context.Context, id string, mutate func(*TemplateProperties) error) (*Operation, error)
+	DeleteTemplate(ctx context.Context, id string) (*Operation, error)
+	GetTemplateEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error)
+	GetTemplatesByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Template, error)
+	GetDeletedTemplates(ctx context.Context, opts ...ListOptions) ([]Template, error)
+}
+
 //TemplateList JSON struct of a list of templates
 type TemplateList struct {
 	//Array of templates
@@ -98,57 +114,104 @@ type TemplateCreateRequest struct {
 	Labels []string `json:"labels,omitempty"`
 }
 
+//Validate checks the request before it is sent, see Validator
+func (r TemplateCreateRequest) Validate() error {
+	var v validator
+	v.name("name", r.Name, false)
+	v.required("snapshot_uuid", r.SnapshotUUID)
+	v.labels("labels", r.Labels)
+	return v.err()
+}
+
 //TemplateUpdateRequest JSON struct of a request for updating a template
 type TemplateUpdateRequest struct {
 	//The human-readable name of the object. It supports the full UTF-8 charset, with a maximum of 64 characters.
 	//Optional.
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	//List of labels. Optional.
-	Labels []string `json:"labels,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
+}
+
+//Validate checks the request before it is sent, see Validator
+func (r TemplateUpdateRequest) Validate() error {
+	var v validator
+	if r.Name != nil {
+		v.name("name", *r.Name, false)
+	}
+	if r.Labels != nil {
+		v.labels("labels", *r.Labels)
+	}
+	return v.err()
 }
 
 //GetTemplate gets a template
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getTemplate
-func (c *Client) GetTemplate(id string) (Template, error) {
+func (c *Client) GetTemplate(ctx context.Context, id string) (Template, error) {
 	if !isValidUUID(id) {
-		return Template{}, errors.New("'id' is invalid")
+		return Template{}, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiTemplateBase, id),
 		method: http.MethodGet,
 	}
 	var response Template
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	return response, err
 }
 
 //GetTemplateList gets a list of templates
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getTemplates
-func (c *Client) GetTemplateList() ([]Template, error) {
+func (c *Client) GetTemplateList(ctx context.Context, opts ...ListOptions) ([]Template, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    apiTemplateBase,
+		uri:    apiTemplateBase + options.query(),
 		method: http.MethodGet,
 	}
 	var response TemplateList
 	var templates []Template
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		templates = append(templates, Template{
 			Properties: properties,
 		})
 	}
-	return templates, err
+	return templates, options.apply(&templates)
+}
+
+//TemplatesIter calls yield for all templates, in the order of the response. The response is decoded one object at a
+//time instead of being read into memory as a whole. Iterating stops early when yield returns false or the context is
+//done.
+func (c *Client) TemplatesIter(ctx context.Context, yield func(Template) bool) error {
+	return c.iterate(ctx, apiTemplateBase, "templates", func(key string, dec *json.Decoder) (bool, error) {
+		var template Template
+		if err := dec.Decode(&template.Properties); err != nil {
+			return false, err
+		}
+		if template.Properties.ObjectUUID == "" {
+			template.Properties.ObjectUUID = key
+		}
+		return yield(template), nil
+	})
 }
 
 //GetTemplateByName gets a template by its name
-func (c *Client) GetTemplateByName(name string) (Template, error) {
+func (c *Client) GetTemplateByName(ctx context.Context, name string) (Template, error) {
 	if name == "" {
-		return Template{}, errors.New("'name' is required")
+		return Template{}, newArgumentError("'name' is required", "name")
 	}
-	templates, err := c.GetTemplateList()
+	templates, err := c.GetTemplateList(ctx)
 	if err != nil {
 		return Template{}, err
 	}
@@ -157,145 +220,189 @@ func (c *Client) GetTemplateByName(name string) (Template, error) {
 			return Template{Properties: template.Properties}, nil
 		}
 	}
-	return Template{}, fmt.Errorf("Template %v not found", name)
+	return Template{}, fmt.Errorf("Template %v %w", name, ErrNotFound)
 }
 
 //CreateTemplate creates a template
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/createTemplate
-func (c *Client) CreateTemplate(body TemplateCreateRequest) (CreateResponse, error) {
+func (c *Client) CreateTemplate(ctx context.Context, body TemplateCreateRequest) (CreateResponse, *Operation, error) {
 	r := Request{
 		uri:    apiTemplateBase,
 		method: http.MethodPost,
 		body:   body,
 	}
 	var response CreateResponse
-	err := r.execute(*c, &response)
+	err := r.execute(ctx, c, &response)
 	if err != nil {
-		return CreateResponse{}, err
+		return CreateResponse{}, nil, err
 	}
-	if c.cfg.sync {
-		err = c.waitForRequestCompleted(response.RequestUUID)
-	}
-	return response, err
+	op, err := c.startOperation(ctx, OperationCreate, "template", response.ObjectUUID, response.RequestUUID, func(ctx context.Context) error {
+		return c.waitForRequestCompleted(ctx, response.RequestUUID)
+	})
+	return response, op, err
 }
 
 //UpdateTemplate updates a template
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/updateTemplate
-func (c *Client) UpdateTemplate(id string, body TemplateUpdateRequest) error {
+func (c *Client) UpdateTemplate(ctx context.Context, id string, body TemplateUpdateRequest) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiTemplateBase, id),
 		method: http.MethodPatch,
 		body:   body,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForTemplateActive(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationUpdate, "template", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForTemplateActive(ctx, id)
+	})
+}
+
+//UpdateTemplateFunc updates a template by applying mutate to a copy of its current properties. Only the properties
+//which have been changed are sent, and nothing is sent if there are none. If the object is changed concurrently,
+//it is read again and mutate is applied again, see ConcurrentModificationError.
+func (c *Client) UpdateTemplateFunc(ctx context.Context, id string, mutate func(*TemplateProperties) error) (*Operation, error) {
+	var body TemplateUpdateRequest
+	return c.updateFunc(ctx, updateFuncSpec{
+		objectType: "template",
+		objectUUID: id,
+		get: func(ctx context.Context) (interface{}, error) {
+			object, err := c.GetTemplate(ctx, id)
+			return object.Properties, err
+		},
+		mutate: func(properties interface{}) error {
+			return mutate(properties.(*TemplateProperties))
+		},
+		body: &body,
+		update: func(ctx context.Context) (*Operation, error) {
+			return c.UpdateTemplate(ctx, id, body)
+		},
+	})
 }
 
 //DeleteTemplate deletes a template
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/deleteTemplate
-func (c *Client) DeleteTemplate(id string) error {
+func (c *Client) DeleteTemplate(ctx context.Context, id string) (*Operation, error) {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
 	}
 	r := Request{
 		uri:    path.Join(apiTemplateBase, id),
 		method: http.MethodDelete,
 	}
-	if c.cfg.sync {
-		err := r.execute(*c, nil)
-		if err != nil {
-			return err
-		}
-		//Block until the request is finished
-		return c.waitForTemplateDeleted(id)
+	err := r.execute(ctx, c, nil)
+	if err != nil {
+		return nil, err
 	}
-	return r.execute(*c, nil)
+	return c.startOperation(ctx, OperationDelete, "template", id, r.requestUUID, func(ctx context.Context) error {
+		return c.waitForTemplateDeleted(ctx, id)
+	})
 }
 
 //GetTemplateEventList gets a list of a template's events
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getTemplateEvents
-func (c *Client) GetTemplateEventList(id string) ([]Event, error) {
+func (c *Client) GetTemplateEventList(ctx context.Context, id string, opts ...ListOptions) ([]Event, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiTemplateBase, id, "events"),
+		uri:    path.Join(apiTemplateBase, id, "events") + options.query(),
 		method: http.MethodGet,
 	}
 	var response EventList
 	var templateEvents []Event
-	err := r.execute(*c, &response)
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
 	for _, properties := range response.List {
 		templateEvents = append(templateEvents, Event{Properties: properties})
 	}
-	return templateEvents, err
+	return templateEvents, options.apply(&templateEvents)
 }
 
 //GetTemplatesByLocation gets a list of templates by location
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getLocationTemplates
-func (c *Client) GetTemplatesByLocation(id string) ([]Template, error) {
+func (c *Client) GetTemplatesByLocation(ctx context.Context, id string, opts ...ListOptions) ([]Template, error) {
 	if !isValidUUID(id) {
-		return nil, errors.New("'id' is invalid")
+		return nil, newArgumentError("'id' is invalid", "id")
+	}
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
 	}
 	r := Request{
-		uri:    path.Join(apiLocationBase, id, "templates"),
+		uri:    path.Join(apiLocationBase, id, "templates") + options.query(),
 		method: http.MethodGet,
 	}
 	var response TemplateList
 	var templates []Template
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		templates = append(templates, Template{Properties: properties})
 	}
-	return templates, err
+	return templates, options.apply(&templates)
 }
 
 //GetDeletedTemplates gets a list of deleted templates
 //
 //See: https://gridscale.io/en//api-documentation/index.html#operation/getDeletedTemplates
-func (c *Client) GetDeletedTemplates() ([]Template, error) {
+func (c *Client) GetDeletedTemplates(ctx context.Context, opts ...ListOptions) ([]Template, error) {
+	options, err := listOptionsOf(opts)
+	if err != nil {
+		return nil, err
+	}
 	r := Request{
-		uri:    path.Join(apiDeletedBase, "templates"),
+		uri:    path.Join(apiDeletedBase, "templates") + options.query(),
 		method: http.MethodGet,
 	}
 	var response DeletedTemplateList
 	var templates []Template
-	err := r.execute(*c, &response)
-	for _, properties := range response.List {
+	err = r.execute(ctx, c, &response)
+	if err != nil {
+		return nil, err
+	}
+	for key, properties := range response.List {
+		if properties.ObjectUUID == "" {
+			properties.ObjectUUID = key
+		}
 		templates = append(templates, Template{Properties: properties})
 	}
-	return templates, err
+	return templates, options.apply(&templates)
 }
 
 //waitForTemplateActive allows to wait until the template's status is active
-func (c *Client) waitForTemplateActive(id string) error {
-	return retryWithTimeout(func() (bool, error) {
-		template, err := c.GetTemplate(id)
-		return template.Properties.Status != resourceActiveStatus, err
-	}, c.cfg.requestCheckTimeoutSecs, c.cfg.delayInterval)
+func (c *Client) waitForTemplateActive(ctx context.Context, id string) error {
+	return WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.GetTemplate(ctx, id)
+	}, ConditionActive, c.waitOptions())
 }
 
 //waitForTemplateDeleted allows to wait until the template is deleted
-func (c *Client) waitForTemplateDeleted(id string) error {
+func (c *Client) waitForTemplateDeleted(ctx context.Context, id string) error {
 	if !isValidUUID(id) {
-		return errors.New("'id' is invalid")
+		return newArgumentError("'id' is invalid", "id")
 	}
 	uri := path.Join(apiTemplateBase, id)
 	method := http.MethodGet
-	return c.waitFor404Status(uri, method)
+	return c.waitFor404Status(ctx, uri, method)
 }
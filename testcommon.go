@@ -1,6 +1,7 @@
 package gsclient
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"time"
@@ -11,6 +12,8 @@ const (
 	dummyRequestUUID = "x123xx1x-123x-1x12-123x-123xxx123x1x"
 )
 
+var emptyCtx = context.Background()
+
 var dummyTimeOriginal, _ = time.Parse(gsTimeLayout, "2018-04-28T09:47:41Z")
 var dummyTime = GSTime{dummyTimeOriginal}
 
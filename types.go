@@ -64,6 +64,11 @@ func (l loadbalancerAlgorithm) MarshalJSON() ([]byte, error) {
 	return json.Marshal(l.string)
 }
 
+//UnmarshalJSON custom unmarshal for loadbalancerAlgorithm
+func (l *loadbalancerAlgorithm) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, &l.string)
+}
+
 type passwordType struct {
 	string
 }
@@ -0,0 +1,168 @@
+package gsclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+//maxUpdateFuncAttempts is how often the UpdateXxxFunc functions try to update an object which is changed concurrently
+const maxUpdateFuncAttempts = 5
+
+//updateFuncSpec describes how the UpdateXxxFunc functions read and update an object
+type updateFuncSpec struct {
+	//Type and UUID of the object, used for the returned operation and errors
+	objectType string
+	objectUUID string
+
+	//get reads the properties of the object (not a pointer to them)
+	get func(ctx context.Context) (interface{}, error)
+
+	//mutate applies the caller's changes to a pointer to a copy of the properties
+	mutate func(properties interface{}) error
+
+	//body points to the update request, which is filled with the changed properties before update is called
+	body interface{}
+
+	//update sends the update request
+	update func(ctx context.Context) (*Operation, error)
+}
+
+//updateFunc implements the UpdateXxxFunc functions. It reads the object, applies the caller's changes to a copy
+//of its properties and sends only the properties which have been changed.
+//
+//The API has no conditional updates, so concurrent changes are detected optimistically: the object is read again
+//right before the update is sent, and if its change time differs (or the API answers with a conflict), everything
+//is repeated with the fresh properties. After maxUpdateFuncAttempts a ConcurrentModificationError is returned.
+//If nothing has been changed, no request is sent and a done operation is returned.
+func (c *Client) updateFunc(ctx context.Context, spec updateFuncSpec) (*Operation, error) {
+	//cached properties would defeat the detection of concurrent changes
+	ctx = WithoutCache(ctx)
+	current, err := spec.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		modified, err := copyProperties(current)
+		if err != nil {
+			return nil, err
+		}
+		err = spec.mutate(modified.Interface())
+		if err != nil {
+			return nil, err
+		}
+		changed, err := diffProperties(spec.objectType, spec.body, reflect.ValueOf(current), modified.Elem())
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			return newOperation(OperationUpdate, spec.objectType, spec.objectUUID, "", nil), nil
+		}
+		latest, err := spec.get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if changeTimeOf(latest).Equal(changeTimeOf(current).Time) {
+			op, err := spec.update(ctx)
+			if !errors.Is(err, ErrConflict) {
+				return op, err
+			}
+			latest, err = spec.get(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if attempt == maxUpdateFuncAttempts {
+			return nil, ConcurrentModificationError{
+				ObjectType: spec.objectType,
+				ObjectUUID: spec.objectUUID,
+				Attempts:   attempt,
+			}
+		}
+		c.cfg.logger.Debug("Object has been changed concurrently, retrying the update", Fields{
+			"object_type": spec.objectType,
+			"object_uuid": spec.objectUUID,
+			"attempt":     attempt,
+		})
+		current = latest
+	}
+}
+
+//copyProperties returns a pointer to a deep copy of the given properties
+func copyProperties(properties interface{}) (reflect.Value, error) {
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	copied := reflect.New(reflect.TypeOf(properties))
+	err = json.Unmarshal(data, copied.Interface())
+	return copied, err
+}
+
+//changeTimeOf returns the change time of the given properties
+func changeTimeOf(properties interface{}) GSTime {
+	changeTime, _ := reflect.ValueOf(properties).FieldByName("ChangeTime").Interface().(GSTime)
+	return changeTime
+}
+
+//diffProperties resets the update request body points to and sets all of its fields whose property differs
+//between old and modified. The properties are compared by their JSON encoding and matched to the fields of
+//the request by their JSON names. Changing a property which cannot be updated is an ArgumentError.
+func diffProperties(objectType string, body interface{}, old, modified reflect.Value) (bool, error) {
+	request := reflect.ValueOf(body).Elem()
+	request.Set(reflect.Zero(request.Type()))
+	fields := make(map[string]reflect.Value)
+	for i := 0; i < request.NumField(); i++ {
+		if name := jsonName(request.Type().Field(i)); name != "" {
+			fields[name] = request.Field(i)
+		}
+	}
+	var changed bool
+	for i := 0; i < old.NumField(); i++ {
+		name := jsonName(old.Type().Field(i))
+		if name == "" {
+			continue
+		}
+		oldJSON, err := json.Marshal(old.Field(i).Interface())
+		if err != nil {
+			return false, err
+		}
+		newJSON, err := json.Marshal(modified.Field(i).Interface())
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(oldJSON, newJSON) {
+			continue
+		}
+		field, ok := fields[name]
+		if !ok {
+			return false, newArgumentError(fmt.Sprintf("property '%s' of a %s cannot be updated", name, objectType), "mutate")
+		}
+		value := reflect.New(field.Type().Elem())
+		if err := json.Unmarshal(newJSON, value.Interface()); err != nil {
+			return false, err
+		}
+		field.Set(value)
+		changed = true
+	}
+	return changed, nil
+}
+
+//jsonName returns the JSON name of a struct field, or an empty string if it is not encoded
+func jsonName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
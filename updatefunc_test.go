@@ -0,0 +1,195 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//serveUpdateFuncServer serves a server whose change time is returned by changeTime for every read, and records
+//the bodies of all updates
+func serveUpdateFuncServer(mux *http.ServeMux, changeTime func(read int) GSTime, updateStatus int) *[]string {
+	var reads int
+	var bodies []string
+	mux.HandleFunc(path.Join(apiServerBase, dummyUUID), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			reads++
+			server := getMockServer(true, "active")
+			server.Properties.Labels = []string{"a"}
+			server.Properties.ChangeTime = changeTime(reads)
+			json.NewEncoder(w).Encode(server)
+		case http.MethodPatch:
+			body, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			w.WriteHeader(updateStatus)
+		}
+	})
+	return &bodies
+}
+
+func TestClient_UpdateServerFunc(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	bodies := serveUpdateFuncServer(mux, func(int) GSTime { return dummyTime }, http.StatusNoContent)
+	op, err := client.UpdateServerFunc(emptyCtx, dummyUUID, func(properties *ServerProperties) error {
+		properties.Cores += 2
+		properties.Labels = append(properties.Labels, "b")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "server", op.ObjectType)
+	if assert.Len(t, *bodies, 1) {
+		assert.JSONEq(t, `{"cores": 6, "labels": ["a", "b"]}`, (*bodies)[0])
+	}
+}
+
+func TestClient_UpdateServerFunc_NoChanges(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	bodies := serveUpdateFuncServer(mux, func(int) GSTime { return dummyTime }, http.StatusNoContent)
+	op, err := client.UpdateServerFunc(emptyCtx, dummyUUID, func(properties *ServerProperties) error {
+		properties.Labels[0] = "a"
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, op.Done())
+	assert.Empty(t, *bodies)
+}
+
+func TestClient_UpdateServerFunc_Retry(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	//the server is changed between the first and the second read
+	bodies := serveUpdateFuncServer(mux, func(read int) GSTime {
+		if read == 1 {
+			return dummyTime
+		}
+		return GSTime{dummyTime.Add(time.Minute)}
+	}, http.StatusNoContent)
+	var calls int
+	_, err := client.UpdateServerFunc(emptyCtx, dummyUUID, func(properties *ServerProperties) error {
+		calls++
+		properties.Name = "renamed"
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestClient_UpdateServerFunc_Conflict(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	bodies := serveUpdateFuncServer(mux, func(read int) GSTime {
+		return GSTime{dummyTime.Add(time.Duration(read) * time.Minute)}
+	}, http.StatusNoContent)
+	var calls int
+	_, err := client.UpdateServerFunc(emptyCtx, dummyUUID, func(properties *ServerProperties) error {
+		calls++
+		properties.Name = "renamed"
+		return nil
+	})
+	assert.True(t, errors.Is(err, ErrConflict))
+	var conflict ConcurrentModificationError
+	if assert.True(t, errors.As(err, &conflict)) {
+		assert.Equal(t, maxUpdateFuncAttempts, conflict.Attempts)
+		assert.Equal(t, dummyUUID, conflict.ObjectUUID)
+	}
+	assert.Equal(t, maxUpdateFuncAttempts, calls)
+	assert.Empty(t, *bodies)
+}
+
+func TestClient_UpdateServerFunc_ConflictResponse(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	bodies := serveUpdateFuncServer(mux, func(int) GSTime { return dummyTime }, http.StatusConflict)
+	_, err := client.UpdateServerFunc(emptyCtx, dummyUUID, func(properties *ServerProperties) error {
+		properties.Memory = 8
+		return nil
+	})
+	assert.True(t, errors.Is(err, ErrConflict))
+	assert.Len(t, *bodies, maxUpdateFuncAttempts)
+}
+
+func TestClient_UpdateServerFunc_Errors(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	bodies := serveUpdateFuncServer(mux, func(int) GSTime { return dummyTime }, http.StatusNoContent)
+
+	mutateErr := errors.New("mutate failed")
+	_, err := client.UpdateServerFunc(emptyCtx, dummyUUID, func(properties *ServerProperties) error {
+		properties.Name = "renamed"
+		return mutateErr
+	})
+	assert.Equal(t, mutateErr, err)
+
+	_, err = client.UpdateServerFunc(emptyCtx, dummyUUID, func(properties *ServerProperties) error {
+		properties.Status = "deleted"
+		return nil
+	})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+
+	_, err = client.UpdateServerFunc(emptyCtx, "", func(properties *ServerProperties) error {
+		return nil
+	})
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+	assert.Empty(t, *bodies)
+}
+
+func TestUpdateRequests_MatchProperties(t *testing.T) {
+	//every field of an update request has to be set from a property of the same name by diffProperties
+	cases := []struct {
+		request    interface{}
+		properties interface{}
+		ignored    []string
+	}{
+		{ServerUpdateRequest{}, ServerProperties{}, nil},
+		{StorageUpdateRequest{}, StorageProperties{}, nil},
+		{NetworkUpdateRequest{}, NetworkProperties{}, nil},
+		{IPUpdateRequest{}, IPProperties{}, nil},
+		{ISOImageUpdateRequest{}, ISOImageProperties{}, nil},
+		{FirewallUpdateRequest{}, FirewallProperties{}, nil},
+		{LoadBalancerUpdateRequest{}, LoadBalancerProperties{}, nil},
+		{PaaSServiceUpdateRequest{}, PaaSServiceProperties{}, nil},
+		{PaaSSecurityZoneUpdateRequest{}, PaaSSecurityZoneProperties{}, []string{"paas_security_zone_uuid"}},
+		{SshkeyUpdateRequest{}, SshkeyProperties{}, nil},
+		{TemplateUpdateRequest{}, TemplateProperties{}, nil},
+		{StorageSnapshotUpdateRequest{}, StorageSnapshotProperties{}, nil},
+		{StorageSnapshotScheduleUpdateRequest{}, StorageSnapshotScheduleProperties{}, nil},
+	}
+	for _, test := range cases {
+		properties := make(map[string]bool)
+		propertiesType := reflect.TypeOf(test.properties)
+		for i := 0; i < propertiesType.NumField(); i++ {
+			properties[jsonName(propertiesType.Field(i))] = true
+		}
+		for _, name := range test.ignored {
+			properties[name] = true
+		}
+		requestType := reflect.TypeOf(test.request)
+		for i := 0; i < requestType.NumField(); i++ {
+			name := jsonName(requestType.Field(i))
+			assert.True(t, properties[name], fmt.Sprintf("%s.%s", requestType.Name(), name))
+		}
+	}
+}
+
+func TestDiffProperties_LoadBalancer(t *testing.T) {
+	old := LoadBalancerProperties{Name: "test", Algorithm: "roundrobin", Labels: []string{"a"}}
+	modified := old
+	modified.Algorithm = "leastconn"
+	var body LoadBalancerUpdateRequest
+	changed, err := diffProperties("loadbalancer", &body, reflect.ValueOf(old), reflect.ValueOf(modified))
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, LoadBalancerUpdateRequest{Algorithm: &LoadbalancerLeastConnAlg}, body)
+}
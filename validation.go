@@ -0,0 +1,178 @@
+package gsclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+//Limits of the API which are checked before a request is sent
+const (
+	maxNameLength      = 64
+	minStorageCapacity = 1
+	maxStorageCapacity = 4096
+	minRunInterval     = 60
+	minKeepSnapshots   = 1
+	maxServerNetworks  = 7
+	minPort            = 1
+	maxPort            = 65535
+)
+
+//Validator is implemented by all request bodies which can be checked before they are sent.
+//Bodies implementing it are validated automatically, an invalid body is never sent to the API.
+type Validator interface {
+	//Validate returns a ValidationError listing all invalid fields, or nil if the body is valid
+	Validate() error
+}
+
+//FieldError describes an invalid field of a request body
+type FieldError struct {
+	//JSON name of the field, e.g. "relations.networks" or "rules.rules-v4-in[0].dst_port"
+	Field string
+
+	//Description of the problem
+	Message string
+}
+
+//Error just returns error as string
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+//ValidationError is returned when a request body is invalid. It lists all invalid fields, and matches
+//ErrInvalidArgument when checked with errors.Is.
+type ValidationError struct {
+	//All invalid fields
+	Fields []FieldError
+}
+
+//Error just returns error as string
+func (e ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Error())
+	}
+	return "invalid request: " + strings.Join(messages, "; ")
+}
+
+//Is reports whether the target is ErrInvalidArgument
+func (e ValidationError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+//validator collects the invalid fields of a request body
+type validator struct {
+	fields []FieldError
+}
+
+//add adds an invalid field
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+//err returns a ValidationError if any field is invalid
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return ValidationError{Fields: v.fields}
+}
+
+//required checks that a string is not empty
+func (v *validator) required(field, value string) {
+	if value == "" {
+		v.add(field, "is required")
+	}
+}
+
+//name checks the length of a name, an empty name is only valid if it is optional
+func (v *validator) name(field, value string, optional bool) {
+	if !optional {
+		v.required(field, value)
+	}
+	if utf8.RuneCountInString(value) > maxNameLength {
+		v.add(field, "has to be at most %d characters long", maxNameLength)
+	}
+}
+
+//atLeast checks the minimum of a number
+func (v *validator) atLeast(field string, value, min int) {
+	if value < min {
+		v.add(field, "has to be at least %d", min)
+	}
+}
+
+//between checks the range of a number
+func (v *validator) between(field string, value, min, max int) {
+	if value < min || value > max {
+		v.add(field, "has to be between %d and %d", min, max)
+	}
+}
+
+//oneOf checks that a string is one of the allowed values, an empty string is only valid if it is optional
+func (v *validator) oneOf(field, value string, optional bool, allowed ...string) {
+	if value == "" && optional {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.add(field, "has to be one of %s", strings.Join(allowed, ", "))
+}
+
+//port checks a port, or a port range separated by a colon. An empty string is valid.
+func (v *validator) port(field, value string) {
+	if value == "" {
+		return
+	}
+	for _, p := range strings.SplitN(value, ":", 2) {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < minPort || n > maxPort {
+			v.add(field, "has to be a port or a range of ports between %d and %d", minPort, maxPort)
+			return
+		}
+	}
+}
+
+//firewallRules checks all rules of a firewall
+func (v *validator) firewallRules(field string, rules FirewallRules) {
+	lists := []struct {
+		name  string
+		rules []FirewallRuleProperties
+	}{
+		{"rules-v6-in", rules.RulesV6In},
+		{"rules-v6-out", rules.RulesV6Out},
+		{"rules-v4-in", rules.RulesV4In},
+		{"rules-v4-out", rules.RulesV4Out},
+	}
+	for _, list := range lists {
+		for i, rule := range list.rules {
+			prefix := fmt.Sprintf("%s.%s[%d]", field, list.name, i)
+			v.oneOf(prefix+".protocol", rule.Protocol, true, "tcp", "udp")
+			v.oneOf(prefix+".action", rule.Action, false, "accept", "drop")
+			v.port(prefix+".dst_port", rule.DstPort)
+			v.port(prefix+".src_port", rule.SrcPort)
+		}
+	}
+}
+
+//labels checks a list of labels
+func (v *validator) labels(field string, labels []string) {
+	for i, label := range labels {
+		if label == "" {
+			v.add(fmt.Sprintf("%s[%d]", field, i), "must not be empty")
+		}
+	}
+}
+
+//forwardingRules checks the ports of the forwarding rules of a loadbalancer
+func (v *validator) forwardingRules(field string, rules []ForwardingRule) {
+	for i, rule := range rules {
+		prefix := fmt.Sprintf("%s[%d]", field, i)
+		v.between(prefix+".listen_port", rule.ListenPort, minPort, maxPort)
+		v.between(prefix+".target_port", rule.TargetPort, minPort, maxPort)
+	}
+}
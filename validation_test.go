@@ -0,0 +1,156 @@
+package gsclient
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//fieldsOf returns the names of the invalid fields of a validation error
+func fieldsOf(t *testing.T, err error) []string {
+	var validationError ValidationError
+	if err == nil || !assert.True(t, errors.As(err, &validationError), "unexpected error %v", err) {
+		return nil
+	}
+	var fields []string
+	for _, field := range validationError.Fields {
+		fields = append(fields, field.Field)
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	longName := strings.Repeat("ä", maxNameLength+1)
+	type testCase struct {
+		body   Validator
+		fields []string
+	}
+	testCases := []testCase{
+		{
+			body: StorageCreateRequest{Name: "test", Capacity: 10},
+		},
+		{
+			body:   StorageCreateRequest{Name: longName, Capacity: 0},
+			fields: []string{"capacity", "name"},
+		},
+		{
+			body:   StorageCreateRequest{Name: "test", Capacity: maxStorageCapacity + 1, Labels: []string{""}},
+			fields: []string{"capacity", "labels[0]"},
+		},
+		{
+			body: StorageUpdateRequest{},
+		},
+		{
+			body:   StorageUpdateRequest{Capacity: Int(0)},
+			fields: []string{"capacity"},
+		},
+		{
+			body: ServerCreateRequest{Name: strings.Repeat("ä", maxNameLength), Cores: 1, Memory: 1},
+		},
+		{
+			body: ServerCreateRequest{
+				Relations: &ServerCreateRequestRelations{
+					Networks: make([]ServerCreateRequestNetwork, maxServerNetworks+1),
+				},
+			},
+			fields: []string{"name", "memory", "cores", "relations.networks"},
+		},
+		{
+			body:   ServerUpdateRequest{Name: String(longName), Cores: Int(-1)},
+			fields: []string{"name", "cores"},
+		},
+		{
+			body:   StorageSnapshotScheduleCreateRequest{Name: "test", RunInterval: 59},
+			fields: []string{"run_interval", "keep_snapshots"},
+		},
+		{
+			body: StorageSnapshotScheduleUpdateRequest{Name: String("test")},
+		},
+		{
+			body:   StorageSnapshotScheduleUpdateRequest{KeepSnapshots: Int(0)},
+			fields: []string{"keep_snapshots"},
+		},
+		{
+			body: FirewallCreateRequest{
+				Name: "test",
+				Rules: FirewallRules{
+					RulesV4In: []FirewallRuleProperties{
+						{Protocol: "tcp", DstPort: "20:21", SrcPort: "65535", Action: "accept"},
+						{Protocol: "icmp", DstPort: "0", SrcPort: "80:abc", Action: "reject"},
+					},
+					RulesV6Out: []FirewallRuleProperties{
+						{DstPort: "65536"},
+					},
+				},
+			},
+			fields: []string{
+				"rules.rules-v6-out[0].action",
+				"rules.rules-v6-out[0].dst_port",
+				"rules.rules-v4-in[1].protocol",
+				"rules.rules-v4-in[1].action",
+				"rules.rules-v4-in[1].dst_port",
+				"rules.rules-v4-in[1].src_port",
+			},
+		},
+		{
+			body: FirewallCreateRequest{
+				Name: "test",
+				Rules: FirewallRules{
+					RulesV4Out: []FirewallRuleProperties{{Protocol: "udp", DstPort: "53"}},
+				},
+			},
+			fields: []string{"rules.rules-v4-out[0].action"},
+		},
+		{
+			body: LoadBalancerCreateRequest{
+				Name:            "test",
+				Algorithm:       LoadbalancerRoundrobinAlg,
+				ForwardingRules: []ForwardingRule{{ListenPort: 443, TargetPort: 0}},
+			},
+			fields: []string{"forwarding_rules[0].target_port"},
+		},
+		{
+			body:   NetworkUpdateRequest{Name: String("")},
+			fields: []string{"name"},
+		},
+		{
+			body:   IPCreateRequest{},
+			fields: []string{"family"},
+		},
+		{
+			body:   ServerStorageRelationCreateRequest{},
+			fields: []string{"object_uuid"},
+		},
+	}
+	for _, test := range testCases {
+		err := test.body.Validate()
+		if test.fields == nil {
+			assert.Nil(t, err, "%T", test.body)
+			continue
+		}
+		assert.True(t, errors.Is(err, ErrInvalidArgument))
+		assert.Equal(t, test.fields, fieldsOf(t, err), "%T", test.body)
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := StorageCreateRequest{Capacity: 0}.Validate()
+	assert.Equal(t, "invalid request: capacity has to be between 1 and 4096; name is required", err.Error())
+}
+
+func TestClient_InvalidRequestIsNotSent(t *testing.T) {
+	server, client, mux := setupTestClient(true)
+	defer server.Close()
+	var requests int
+	mux.HandleFunc(apiStorageBase, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+	_, _, err := client.CreateStorage(emptyCtx, StorageCreateRequest{Name: "test", Capacity: 5000})
+	assert.Equal(t, []string{"capacity"}, fieldsOf(t, err))
+	_, err = client.UpdateStorage(emptyCtx, dummyUUID, StorageUpdateRequest{Capacity: Int(5000)})
+	assert.Equal(t, []string{"capacity"}, fieldsOf(t, err))
+	assert.Equal(t, 0, requests)
+}
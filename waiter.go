@@ -0,0 +1,172 @@
+package gsclient
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+)
+
+//Getter fetches the current state of an object, e.g.
+//
+//	func(ctx context.Context) (interface{}, error) { return client.GetServer(ctx, id) }
+type Getter func(ctx context.Context) (interface{}, error)
+
+//Condition checks the result of a Getter. It returns true when the waited for state has been reached.
+//Returning an error stops waiting immediately.
+type Condition func(object interface{}, err error) (bool, error)
+
+//WaitOptions controls how often and how long WaitUntil polls.
+//Zero values are replaced by the defaults of the package.
+type WaitOptions struct {
+	//Delay between two attempts, default 500ms
+	Interval time.Duration
+
+	//Maximum duration of waiting, default 120s
+	Timeout time.Duration
+
+	//Factor by which the interval grows after each attempt, e.g. 2 to double it.
+	//Values lower than or equal to 1 keep the interval constant.
+	Backoff float64
+
+	//Upper limit of the interval when Backoff is used, default 10s
+	MaxInterval time.Duration
+}
+
+//withDefaults replaces zero values with the defaults of the package
+func (o WaitOptions) withDefaults() WaitOptions {
+	if o.Interval <= 0 {
+		o.Interval = defaultDelayIntervalMilliSecs * time.Millisecond
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = defaultCheckRequestTimeoutSecs * time.Second
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = defaultMaxRetryDelaySecs * time.Second
+	}
+	return o
+}
+
+//WaitUntil calls the getter until the condition is met, the condition returns an error, the timeout is reached
+//or the context is done. The first attempt is made right away.
+//
+//When the timeout is reached, ErrTimeout is returned, or the last error returned by the getter if there was one.
+//Requests of the getter bypass the response cache (see WithoutCache).
+func WaitUntil(ctx context.Context, getter Getter, condition Condition, opts WaitOptions) error {
+	opts = opts.withDefaults()
+	//polling has to see the latest state of the object
+	ctx = WithoutCache(ctx)
+	timer := time.NewTimer(opts.Timeout)
+	defer timer.Stop()
+	interval := opts.Interval
+	for {
+		object, err := getter(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		done, condErr := condition(object, err)
+		if condErr != nil {
+			return condErr
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			if err != nil {
+				return err
+			}
+			return ErrTimeout
+		case <-time.After(interval):
+		}
+		if opts.Backoff > 1 {
+			interval = time.Duration(float64(interval) * opts.Backoff)
+			if interval > opts.MaxInterval {
+				interval = opts.MaxInterval
+			}
+		}
+	}
+}
+
+//ConditionActive is met when the status of the object is "active"
+func ConditionActive(object interface{}, err error) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+	status, _ := propertyOf(object, "Status").(string)
+	return status == resourceActiveStatus, nil
+}
+
+//ConditionDeleted is met when the getter returns an error matching ErrNotFound
+func ConditionDeleted(object interface{}, err error) (bool, error) {
+	if errors.Is(err, ErrNotFound) {
+		return true, nil
+	}
+	return false, err
+}
+
+//ConditionPoweredOn is met when the object (e.g. a server) is powered on
+func ConditionPoweredOn(object interface{}, err error) (bool, error) {
+	return conditionPower(object, err, true)
+}
+
+//ConditionPoweredOff is met when the object (e.g. a server) is powered off
+func ConditionPoweredOff(object interface{}, err error) (bool, error) {
+	return conditionPower(object, err, false)
+}
+
+//ConditionRelationExists is met as soon as the getter succeeds, e.g. when GetServerStorage finds the relation.
+//Errors matching ErrNotFound are ignored.
+func ConditionRelationExists(object interface{}, err error) (bool, error) {
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+//ConditionRelationGone is met when the getter returns an error matching ErrNotFound
+func ConditionRelationGone(object interface{}, err error) (bool, error) {
+	return ConditionDeleted(object, err)
+}
+
+//conditionPower checks the power state of an object
+func conditionPower(object interface{}, err error, power bool) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+	isOn, _ := propertyOf(object, "Power").(bool)
+	return isOn == power, nil
+}
+
+//propertyOf returns the value of a field of an object, or of its Properties if it has any.
+//It returns nil if there is no such field.
+func propertyOf(object interface{}, name string) interface{} {
+	v := reflect.ValueOf(object)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	if properties := v.FieldByName("Properties"); properties.IsValid() && properties.Kind() == reflect.Struct {
+		v = properties
+	}
+	field := v.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+	return field.Interface()
+}
+
+//waitOptions returns the wait options configured for the client
+func (c *Client) waitOptions() WaitOptions {
+	return WaitOptions{
+		Interval: c.cfg.delayInterval,
+		Timeout:  c.cfg.requestCheckTimeoutSecs,
+	}
+}
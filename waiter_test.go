@@ -0,0 +1,123 @@
+package gsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitUntil(t *testing.T) {
+	var calls int
+	err := WaitUntil(emptyCtx, func(ctx context.Context) (interface{}, error) {
+		calls++
+		return calls, nil
+	}, func(object interface{}, err error) (bool, error) {
+		return object.(int) == 3, err
+	}, WaitOptions{Interval: time.Millisecond})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWaitUntilTimeout(t *testing.T) {
+	getterErr := errors.New("getter failed")
+	type testCase struct {
+		getterErr error
+		expected  error
+	}
+	testCases := []testCase{
+		{getterErr: nil, expected: ErrTimeout},
+		{getterErr: getterErr, expected: getterErr},
+	}
+	for _, test := range testCases {
+		err := WaitUntil(emptyCtx, func(ctx context.Context) (interface{}, error) {
+			return nil, test.getterErr
+		}, func(object interface{}, err error) (bool, error) {
+			return false, nil
+		}, WaitOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond})
+		assert.Equal(t, test.expected, err)
+	}
+}
+
+func TestWaitUntilBackoff(t *testing.T) {
+	var attempts []time.Time
+	WaitUntil(emptyCtx, func(ctx context.Context) (interface{}, error) {
+		attempts = append(attempts, time.Now())
+		return nil, nil
+	}, func(object interface{}, err error) (bool, error) {
+		return len(attempts) == 4, nil
+	}, WaitOptions{Interval: 10 * time.Millisecond, Backoff: 2, MaxInterval: 25 * time.Millisecond})
+	if assert.Len(t, attempts, 4) {
+		assert.True(t, attempts[2].Sub(attempts[1]) >= 20*time.Millisecond)
+		assert.True(t, attempts[3].Sub(attempts[2]) >= 25*time.Millisecond)
+	}
+}
+
+func TestWaitUntilContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(emptyCtx, 20*time.Millisecond)
+	defer cancel()
+	err := WaitUntil(ctx, func(ctx context.Context) (interface{}, error) {
+		return nil, nil
+	}, func(object interface{}, err error) (bool, error) {
+		return false, nil
+	}, WaitOptions{Interval: time.Millisecond})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestConditions(t *testing.T) {
+	notFound := RequestError{StatusCode: http.StatusNotFound}
+	failed := RequestError{StatusCode: http.StatusInternalServerError}
+	type testCase struct {
+		name      string
+		condition Condition
+		object    interface{}
+		err       error
+		done      bool
+		isFailed  bool
+	}
+	testCases := []testCase{
+		{name: "active", condition: ConditionActive, object: getMockStorage("active"), done: true},
+		{name: "active pointer", condition: ConditionActive, object: &Server{Properties: ServerProperties{Status: "active"}}, done: true},
+		{name: "in-provisioning", condition: ConditionActive, object: getMockStorage("in-provisioning"), done: false},
+		{name: "active failed", condition: ConditionActive, err: failed, isFailed: true},
+		{name: "deleted", condition: ConditionDeleted, err: notFound, done: true},
+		{name: "not deleted", condition: ConditionDeleted, object: getMockStorage("active"), done: false},
+		{name: "deleted failed", condition: ConditionDeleted, err: failed, isFailed: true},
+		{name: "powered on", condition: ConditionPoweredOn, object: Server{Properties: ServerProperties{Power: true}}, done: true},
+		{name: "not powered on", condition: ConditionPoweredOn, object: Server{}, done: false},
+		{name: "powered off", condition: ConditionPoweredOff, object: Server{}, done: true},
+		{name: "relation exists", condition: ConditionRelationExists, object: ServerStorageRelationProperties{}, done: true},
+		{name: "relation missing", condition: ConditionRelationExists, err: notFound, done: false},
+		{name: "relation failed", condition: ConditionRelationExists, err: failed, isFailed: true},
+		{name: "relation gone", condition: ConditionRelationGone, err: notFound, done: true},
+	}
+	for _, test := range testCases {
+		done, err := test.condition(test.object, test.err)
+		assert.Equal(t, test.done, done, test.name)
+		assert.Equal(t, test.isFailed, err != nil, test.name)
+	}
+}
+
+func TestWaitUntilWithClient(t *testing.T) {
+	server, client, mux := setupTestClient(false)
+	defer server.Close()
+	var calls int
+	mux.HandleFunc(path.Join(apiStorageBase, dummyUUID), func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		status := "in-provisioning"
+		if calls > 2 {
+			status = "active"
+		}
+		fmt.Fprint(w, prepareStorageHTTPGet(status))
+	})
+	err := WaitUntil(emptyCtx, func(ctx context.Context) (interface{}, error) {
+		return client.GetStorage(ctx, dummyUUID)
+	}, ConditionActive, WaitOptions{Interval: time.Millisecond})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+}